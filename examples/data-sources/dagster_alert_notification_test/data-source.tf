@@ -0,0 +1,14 @@
+# Sends a sample notification on every plan, the plan fails when it can't be delivered
+data "dagster_alert_notification_test" "job_failures" {
+  document = data.dagster_configuration_document.job_failures.json
+}
+
+data "dagster_configuration_document" "job_failures" {
+  yaml_body = file("${path.module}/alert_policies/job_failures.yaml")
+}
+
+resource "dagster_alert_policy" "job_failures" {
+  document = data.dagster_configuration_document.job_failures.json
+
+  depends_on = [data.dagster_alert_notification_test.job_failures]
+}
@@ -0,0 +1,5 @@
+data "dagster_alert_policies" "all" {}
+
+output "disabled_alert_policies" {
+  value = [for policy in data.dagster_alert_policies.all.alert_policies : policy.name if !policy.enabled]
+}
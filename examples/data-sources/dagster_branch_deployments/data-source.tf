@@ -0,0 +1,16 @@
+data "dagster_branch_deployments" "open" {
+  pull_request_status = "OPEN"
+}
+
+data "dagster_team" "developers" {
+  name = "developers"
+}
+
+# Give the developers team access to every open branch deployment
+resource "dagster_team_deployment_grant" "developers" {
+  for_each = { for branch_deployment in data.dagster_branch_deployments.open.branch_deployments : branch_deployment.name => branch_deployment.id }
+
+  team_id       = data.dagster_team.developers.id
+  deployment_id = each.value
+  grant         = "EDITOR"
+}
@@ -1,5 +1,6 @@
 data "dagster_configuration_document" "defaults" {
-  yaml_body = <<YAML
+  document_type = "deployment_settings"
+  yaml_body     = <<YAML
 run_queue:
   max_concurrent_runs: 30
   tag_concurrency_limits: []
@@ -0,0 +1,3 @@
+data "dagster_deployment" "prod" {
+  name = "prod"
+}
@@ -0,0 +1,6 @@
+data "dagster_deployments" "all" {}
+
+data "dagster_deployments" "active_dev" {
+  deployment_type   = "DEV"
+  deployment_status = "ACTIVE"
+}
@@ -0,0 +1,20 @@
+data "dagster_slack_channels" "available" {}
+
+resource "dagster_alert_policy" "job_failures" {
+  name        = "job-failures"
+  event_types = ["JOB_FAILURE"]
+
+  notification_service = {
+    slack = {
+      slack_workspace_name = "my-workspace"
+      slack_channel_name   = "data-alerts"
+    }
+  }
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.dagster_slack_channels.available.channels, "data-alerts")
+      error_message = "The Dagster Slack app can't post to #data-alerts."
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Dagster agent tokens can be imported via id
+terraform import dagster_agent_token.example 42
@@ -0,0 +1,19 @@
+resource "dagster_agent_token" "example" {
+  description = "Agent token used by the Kubernetes agent"
+
+  # Rotate the token every 90 days
+  rotation_days = 90
+
+  # Rotate the token whenever the agent is upgraded
+  keepers = {
+    agent_version = "1.7.0"
+  }
+
+  # Revoke the previous token a week after a rotation
+  previous_token_grace_days = 7
+}
+
+output "agent_token" {
+  value     = dagster_agent_token.example.token
+  sensitive = true
+}
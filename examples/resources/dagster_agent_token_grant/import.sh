@@ -0,0 +1,5 @@
+# Dagster agent token grants can be imported via agent_token_id/deployment_id
+terraform import dagster_agent_token_grant.example 42/1
+
+# Grants in the ORGANIZATION or ALL_BRANCH_DEPLOYMENTS scope can be imported via agent_token_id/deployment_scope
+terraform import dagster_agent_token_grant.branch_deployments 42/ALL_BRANCH_DEPLOYMENTS
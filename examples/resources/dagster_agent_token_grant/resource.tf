@@ -0,0 +1,19 @@
+data "dagster_current_deployment" "current" {}
+
+resource "dagster_agent_token" "example" {
+  description = "Agent token of the production agent"
+}
+
+# Limit the agent token to the current deployment
+resource "dagster_agent_token_grant" "example" {
+  agent_token_id = dagster_agent_token.example.id
+  deployment_id  = data.dagster_current_deployment.current.id
+  grant          = "AGENT" # One of ["VIEWER" "LAUNCHER" "EDITOR" "ADMIN" "AGENT"]
+}
+
+# Allow the agent token to serve all branch deployments
+resource "dagster_agent_token_grant" "branch_deployments" {
+  agent_token_id   = dagster_agent_token.example.id
+  deployment_scope = "ALL_BRANCH_DEPLOYMENTS" # One of ["DEPLOYMENT" "ORGANIZATION" "ALL_BRANCH_DEPLOYMENTS"]
+  grant            = "AGENT"
+}
@@ -0,0 +1,8 @@
+# Alert policies that are not in the document, e.g. created in the UI, are removed
+resource "dagster_alert_policies" "this" {
+  document = data.dagster_configuration_document.alert_policies.json
+}
+
+data "dagster_configuration_document" "alert_policies" {
+  yaml_body = file("${path.module}/alert_policies.yaml")
+}
@@ -0,0 +1,2 @@
+# Dagster Alert Policies can be imported via name
+terraform import dagster_alert_policy.this "job-failures"
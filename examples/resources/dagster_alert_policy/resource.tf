@@ -0,0 +1,63 @@
+resource "dagster_alert_policy" "job_failures" {
+  name        = "job-failures"
+  description = "Notify the data team about failed jobs"
+  event_types = ["JOB_FAILURE", "TICK_FAILURE"]
+
+  tags = {
+    team = "data"
+  }
+
+  notification_service = {
+    slack = {
+      slack_workspace_name = "my-workspace"
+      slack_channel_name   = "data-alerts"
+    }
+  }
+}
+
+resource "dagster_alert_policy" "asset_checks" {
+  name        = "asset-checks"
+  event_types = ["ASSET_CHECK_SEVERITY_ERROR"]
+
+  alert_targets = [
+    {
+      asset_group = {
+        asset_group   = "marts"
+        location_name = "example_code_location"
+      }
+    },
+    {
+      asset_key = ["raw", "orders"]
+    },
+  ]
+
+  notification_service = {
+    pagerduty = {
+      integration_key = var.pagerduty_integration_key
+    }
+  }
+}
+
+# Alert policies can also be created from a dagster-cloud alert policy document
+resource "dagster_alert_policy" "from_document" {
+  document = data.dagster_configuration_document.alert_policy.json
+}
+
+data "dagster_configuration_document" "alert_policy" {
+  yaml_body = <<YAML
+alert_policies:
+  - name: "agent-unavailable"
+    description: "Notify the platform team when the agent is down"
+    event_types:
+      - "AGENT_UNAVAILABLE"
+    notification_service:
+      email:
+        email_addresses:
+          - "platform@example.com"
+YAML
+}
+
+variable "pagerduty_integration_key" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Dagster API tokens can be imported via token_type/id
+terraform import dagster_api_token.scim "SCIM/1a2b3c4d"
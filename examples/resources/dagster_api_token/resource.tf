@@ -0,0 +1,9 @@
+resource "dagster_api_token" "scim" {
+  token_type  = "SCIM" # One of ["SCIM"]
+  description = "SCIM provisioning from Okta"
+}
+
+output "scim_token" {
+  value     = dagster_api_token.scim.token
+  sensitive = true
+}
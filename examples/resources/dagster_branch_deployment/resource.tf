@@ -0,0 +1,14 @@
+resource "dagster_branch_deployment" "example" {
+  repo_name           = "my-org/my-repo"
+  branch_name         = "feature/new-pipeline"
+  branch_url          = "https://github.com/my-org/my-repo/tree/feature/new-pipeline"
+  pull_request_url    = "https://github.com/my-org/my-repo/pull/42"
+  pull_request_number = "42"
+
+  commit = {
+    hash         = "3f786850e387550fdab836ed7e6dc881de23001b"
+    message      = "Add new pipeline"
+    author_name  = "Jane Doe"
+    author_email = "jane.doe@example.com"
+  }
+}
@@ -0,0 +1,2 @@
+# Dagster code locations can be imported via the name of the code location
+terraform import dagster_code_location.example my_code_location
@@ -0,0 +1,4 @@
+# Dagster code locations can be imported via the name of the code location.
+# The full document of the code location is imported, including the defaults filled in by Dagster Cloud,
+# so the next apply updates it to the declared document.
+terraform import dagster_code_location_from_document.example my_code_location
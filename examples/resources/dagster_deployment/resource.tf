@@ -1,6 +1,10 @@
 resource "dagster_deployment" "this" {
   name              = "test-deploy"
+  agent_type        = "HYBRID" # One of ["HYBRID" "SERVERLESS"]
   settings_document = data.dagster_configuration_document.this.json
+
+  # Copy the user and team permissions of the prod deployment
+  inherit_permissions_from = "prod"
 }
 
 data "dagster_configuration_document" "this" {
@@ -24,3 +28,18 @@ auto_materialize:
   use_sensors: false
 YAML
 }
+
+# Settings can also be set as a typed block, which is checked at plan time
+resource "dagster_deployment" "typed" {
+  name = "typed-settings"
+
+  settings = {
+    run_queue = {
+      max_concurrent_runs = 30
+    }
+    run_monitoring = {
+      start_timeout_seconds = 1200
+    }
+    sso_default_role = "VIEWER"
+  }
+}
@@ -0,0 +1,2 @@
+# Deployment settings are imported in REPLACE mode by the id of the deployment
+terraform import dagster_deployment_settings.example 12345
@@ -0,0 +1,16 @@
+data "dagster_deployment" "prod" {
+  name = "prod"
+}
+
+# Only manage the declared settings, other settings are left as they are
+resource "dagster_deployment_settings" "prod" {
+  deployment_id = data.dagster_deployment.prod.id
+  mode          = "MERGE"
+
+  settings_document = jsonencode({
+    sso_default_role = "VIEWER"
+    run_queue = {
+      max_concurrent_runs = 20
+    }
+  })
+}
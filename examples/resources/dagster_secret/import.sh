@@ -0,0 +1,2 @@
+# Dagster Secrets can be imported via name
+terraform import dagster_secret.this "SNOWFLAKE_PASSWORD"
@@ -0,0 +1,18 @@
+# The value is read from the DAGSTER_SNOWFLAKE_PASSWORD environment variable
+# of the machine running Terraform and never stored in the state.
+resource "dagster_secret" "snowflake_password" {
+  name      = "SNOWFLAKE_PASSWORD"
+  value_env = "DAGSTER_SNOWFLAKE_PASSWORD"
+
+  full_deployment_scope        = true
+  all_branch_deployments_scope = true
+  local_deployment_scope       = false
+
+  # Only expose the secret to these code locations, leave empty for all code locations
+  location_names = ["example_code_location"]
+}
+
+resource "dagster_secret" "service_account" {
+  name       = "GCP_SERVICE_ACCOUNT"
+  value_file = "${path.module}/service_account.json"
+}
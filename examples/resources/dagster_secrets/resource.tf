@@ -0,0 +1,14 @@
+# All other secrets in the deployment are removed
+resource "dagster_secrets" "this" {
+  secrets = {
+    SNOWFLAKE_ACCOUNT  = "my-account"
+    SNOWFLAKE_USER     = "dagster"
+    SNOWFLAKE_PASSWORD = var.snowflake_password
+  }
+
+  full_deployment_scope        = true
+  all_branch_deployments_scope = true
+
+  # Only expose the secrets to these code locations, leave empty for all code locations
+  location_names = ["example_code_location"]
+}
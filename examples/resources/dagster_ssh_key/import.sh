@@ -0,0 +1,2 @@
+# Dagster SSH keys can be imported via user_id/ssh_key_id
+terraform import dagster_ssh_key.example 12345/42
@@ -0,0 +1,9 @@
+resource "dagster_user" "example" {
+  email = "jane.doe@example.com"
+}
+
+resource "dagster_ssh_key" "example" {
+  user_id    = dagster_user.example.id
+  public_key = file("~/.ssh/id_ed25519.pub")
+  name       = "jane-laptop"
+}
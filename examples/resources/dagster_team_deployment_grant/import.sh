@@ -0,0 +1,5 @@
+# Dagster team deployment grants can be imported via team_name/deployment_name
+terraform import dagster_team_deployment_grant.example example_team/prod
+
+# Grants in the ORGANIZATION or ALL_BRANCH_DEPLOYMENTS scope can be imported via team_name/deployment_scope
+terraform import dagster_team_deployment_grant.branch_deployments example_team/ALL_BRANCH_DEPLOYMENTS
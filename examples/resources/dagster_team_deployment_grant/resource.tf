@@ -17,3 +17,11 @@ resource "dagster_team_deployment_grant" "example" {
     },
   ]
 }
+
+# Grant the team a default role on all branch deployments
+resource "dagster_team_deployment_grant" "branch_deployments" {
+  team_id          = dagster_team.example.id
+  deployment_scope = "ALL_BRANCH_DEPLOYMENTS" # One of ["DEPLOYMENT" "ORGANIZATION" "ALL_BRANCH_DEPLOYMENTS"]
+
+  grant = "LAUNCHER"
+}
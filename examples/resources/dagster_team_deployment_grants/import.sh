@@ -0,0 +1,2 @@
+# All deployment grants of a team can be imported via team_name
+terraform import dagster_team_deployment_grants.example example_team
@@ -0,0 +1,22 @@
+resource "dagster_team" "example" {
+  name = "example_team"
+}
+
+resource "dagster_team_deployment_grants" "example" {
+  team_id = dagster_team.example.id
+
+  deployment_grants = {
+    prod = {
+      grant = "VIEWER" # One of ["VIEWER" "LAUNCHER" "EDITOR" "ADMIN" ]
+      code_location_grants = [
+        {
+          name  = "my_code_location"
+          grant = "LAUNCHER" # One of ["LAUNCHER" "EDITOR" "ADMIN"]
+        },
+      ]
+    }
+    staging = {
+      grant = "EDITOR"
+    }
+  }
+}
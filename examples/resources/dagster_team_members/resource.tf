@@ -0,0 +1,12 @@
+resource "dagster_team" "example" {
+  name = "example_team"
+}
+
+resource "dagster_team_members" "example" {
+  team_id = dagster_team.example.id
+
+  user_emails = [
+    "foo.bar@dataroots.io",
+    "baz.qux@dataroots.io",
+  ]
+}
@@ -0,0 +1,2 @@
+# Dagster team memberships can be imported via team_name/user_email
+terraform import dagster_team_membership.example example_team/foo.bar@dataroots.io
@@ -0,0 +1,20 @@
+data "dagster_current_deployment" "current" {}
+
+resource "dagster_user" "example" {
+  email                      = "break-glass-admin@example.com"
+  remove_default_permissions = true
+}
+
+resource "dagster_user_deployment_grant" "example" {
+  deployment_id = data.dagster_current_deployment.current.id
+  user_email    = dagster_user.example.email
+
+  grant = "VIEWER" # One of ["VIEWER" "LAUNCHER" "EDITOR" "ADMIN" ]
+
+  code_location_grants = [
+    {
+      name  = "example_code_location"
+      grant = "EDITOR" # One of ["LAUNCHER" "EDITOR" "ADMIN"]
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Dagster user tokens can be imported via user_id/token_id
+terraform import dagster_user_token.ci 12345/42
@@ -0,0 +1,13 @@
+resource "dagster_user" "ci" {
+  email = "ci-service-account@example.com"
+}
+
+resource "dagster_user_token" "ci" {
+  user_id     = dagster_user.ci.id
+  description = "Token used by the CI pipeline"
+}
+
+output "ci_token" {
+  value     = dagster_user_token.ci.token
+  sensitive = true
+}
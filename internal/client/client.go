@@ -5,17 +5,24 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/datarootsio/terraform-provider-dagster/internal/client/service"
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
 )
 
 type DagsterClient struct {
 	Organization string
 	Deployment   string
 
+	apiToken string
+
 	DeploymentClient    service.DeploymentClient
 	UsersClient         service.UsersClient
 	TeamsClient         service.TeamsClient
 	CodeLocationsClient service.CodeLocationsClient
 	InstanceClient      service.InstanceClient
+	SecretsClient       service.SecretsClient
+	AlertPoliciesClient service.AlertPoliciesClient
+	TokensClient        service.TokensClient
+	SSHKeysClient       service.SSHKeysClient
 }
 
 func NewDagsterClient(organization, deployment, apiToken string) (DagsterClient, error) {
@@ -33,10 +40,22 @@ func NewDagsterClient(organization, deployment, apiToken string) (DagsterClient,
 		Organization: organization,
 		Deployment:   deployment,
 
+		apiToken: apiToken,
+
 		DeploymentClient:    service.NewDeploymentClient(gqlClient),
 		UsersClient:         service.NewUsersClient(gqlClient),
 		TeamsClient:         service.NewTeamsClient(gqlClient),
 		CodeLocationsClient: service.NewCodeLocationsClient(gqlClient),
 		InstanceClient:      service.NewInstanceClient(gqlClient),
+		SecretsClient:       service.NewSecretsClient(gqlClient),
+		AlertPoliciesClient: service.NewAlertPoliciesClient(gqlClient),
+		TokensClient:        service.NewTokensClient(gqlClient),
+		SSHKeysClient:       service.NewSSHKeysClient(gqlClient),
 	}, nil
 }
+
+// HashSalt returns a salt for utils.HashStringWithSalt derived from the API token. The same key always gets the same
+// salt, so it can be computed while planning, but it can't be guessed without the API token.
+func (c DagsterClient) HashSalt(key string) string {
+	return utils.HashStringWithSalt(c.apiToken, fmt.Sprintf("%s/%s/%s", c.Organization, c.Deployment, key))
+}
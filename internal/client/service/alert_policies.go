@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+)
+
+type AlertPoliciesClient struct {
+	client graphql.Client
+}
+
+func NewAlertPoliciesClient(client graphql.Client) AlertPoliciesClient {
+	return AlertPoliciesClient{
+		client: client,
+	}
+}
+
+// ListAlertPolicies retrieves all alert policies of the deployment the client is configured with
+func (c *AlertPoliciesClient) ListAlertPolicies(ctx context.Context) ([]types.AlertPolicy, error) {
+	resp, err := schema.ListAlertPoliciesAsDocument(ctx, c.client)
+	if err != nil {
+		return []types.AlertPolicy{}, err
+	}
+
+	alertPolicies := types.AlertPoliciesAsDocumentResponse{}
+	err = json.Unmarshal(resp.AlertPoliciesAsDocument.Document, &alertPolicies)
+	if err != nil {
+		return []types.AlertPolicy{}, err
+	}
+
+	return alertPolicies.AlertPolicies, nil
+}
+
+// GetAllAlertPolicies retrieves all alert policies of the deployment through the alertPolicies query,
+// which unlike the document also includes the ids of the alert policies
+func (c *AlertPoliciesClient) GetAllAlertPolicies(ctx context.Context) ([]schema.AlertPolicy, error) {
+	resp, err := schema.ListAlertPolicies(ctx, c.client)
+	if err != nil {
+		return []schema.AlertPolicy{}, err
+	}
+
+	alertPolicies := make([]schema.AlertPolicy, 0, len(resp.AlertPolicies))
+	for _, alertPolicy := range resp.AlertPolicies {
+		alertPolicies = append(alertPolicies, alertPolicy.AlertPolicy)
+	}
+
+	return alertPolicies, nil
+}
+
+// GetAvailableSlackChannels retrieves the Slack channels the Dagster Slack app can post to
+func (c *AlertPoliciesClient) GetAvailableSlackChannels(ctx context.Context) ([]string, error) {
+	resp, err := schema.ListAvailableSlackChannels(ctx, c.client)
+	if err != nil {
+		return []string{}, err
+	}
+
+	return resp.AvailableSlackChannels, nil
+}
+
+// GetAlertPolicyByName looks up an alert policy by name and returns it
+func (c *AlertPoliciesClient) GetAlertPolicyByName(ctx context.Context, name string) (types.AlertPolicy, error) {
+	alertPolicies, err := c.ListAlertPolicies(ctx)
+	if err != nil {
+		return types.AlertPolicy{}, err
+	}
+
+	for _, alertPolicy := range alertPolicies {
+		if alertPolicy.Name == name {
+			return alertPolicy, nil
+		}
+	}
+
+	return types.AlertPolicy{}, &types.ErrNotFound{What: "AlertPolicy", Key: "name", Value: name}
+}
+
+// GetAlertPolicyAsDocumentByName looks up an alert policy by name and returns it as a JSON document
+func (c *AlertPoliciesClient) GetAlertPolicyAsDocumentByName(ctx context.Context, name string) (json.RawMessage, error) {
+	resp, err := schema.ListAlertPoliciesAsDocument(ctx, c.client)
+	if err != nil {
+		return json.RawMessage{}, err
+	}
+
+	var responseAsJSON map[string][]json.RawMessage
+	err = json.Unmarshal(resp.AlertPoliciesAsDocument.Document, &responseAsJSON)
+	if err != nil {
+		return json.RawMessage{}, err
+	}
+
+	for _, alertPolicyRaw := range responseAsJSON["alert_policies"] {
+		var alertPolicy types.AlertPolicy
+		err := json.Unmarshal(alertPolicyRaw, &alertPolicy)
+		if err != nil {
+			return json.RawMessage{}, err
+		}
+		if alertPolicy.Name == name {
+			return alertPolicyRaw, nil
+		}
+	}
+
+	return json.RawMessage{}, &types.ErrNotFound{What: "AlertPolicyAsDocument", Key: "name", Value: name}
+}
+
+// CreateOrUpdateAlertPolicyFromDocument creates an alert policy, or updates the alert policy with the same name
+func (c *AlertPoliciesClient) CreateOrUpdateAlertPolicyFromDocument(ctx context.Context, document json.RawMessage) error {
+	alertPolicyDocument, err := UnwrapAlertPolicyDocument(document)
+	if err != nil {
+		return err
+	}
+
+	resp, err := schema.CreateOrUpdateAlertPolicyFromDocument(ctx, c.client, alertPolicyDocument)
+	if err != nil {
+		return err
+	}
+
+	switch respCast := resp.CreateOrUpdateAlertPolicyFromDocument.(type) {
+	case *schema.CreateOrUpdateAlertPolicyFromDocumentCreateOrUpdateAlertPolicyFromDocumentAlertPolicy:
+		return nil
+	case *schema.CreateOrUpdateAlertPolicyFromDocumentCreateOrUpdateAlertPolicyFromDocumentInvalidAlertPolicyError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: invalidAlertPolicyErrorMessage(respCast.InvalidAlertPolicyError)}
+	case *schema.CreateOrUpdateAlertPolicyFromDocumentCreateOrUpdateAlertPolicyFromDocumentPythonError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateOrUpdateAlertPolicyFromDocumentCreateOrUpdateAlertPolicyFromDocumentUnauthorizedError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return fmt.Errorf("unexpected type(%T) of result", resp.CreateOrUpdateAlertPolicyFromDocument)
+	}
+}
+
+// DeleteAlertPolicy deletes an alert policy by name
+func (c *AlertPoliciesClient) DeleteAlertPolicy(ctx context.Context, name string) error {
+	_, err := c.GetAlertPolicyByName(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	resp, err := schema.DeleteAlertPolicy(ctx, c.client, name)
+	if err != nil {
+		return err
+	}
+
+	switch respCast := resp.DeleteAlertPolicy.(type) {
+	case *schema.DeleteAlertPolicyDeleteAlertPolicyDeleteAlertPolicySuccess:
+		return nil
+	case *schema.DeleteAlertPolicyDeleteAlertPolicyPythonError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.DeleteAlertPolicyDeleteAlertPolicyUnauthorizedError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return fmt.Errorf("unexpected type(%T) of result", resp.DeleteAlertPolicy)
+	}
+}
+
+// ReconcileAlertPoliciesFromDocument replaces all alert policies of the deployment with the alert policies
+// in the document, alert policies that are not in the document are removed. Returns the names of the resulting alert policies.
+func (c *AlertPoliciesClient) ReconcileAlertPoliciesFromDocument(ctx context.Context, document json.RawMessage) ([]string, error) {
+	resp, err := schema.ReconcileAlertPoliciesFromDocument(ctx, c.client, document)
+	if err != nil {
+		return []string{}, err
+	}
+
+	switch respCast := resp.ReconcileAlertPoliciesFromDocument.(type) {
+	case *schema.ReconcileAlertPoliciesFromDocumentReconcileAlertPoliciesFromDocumentReconcileAlertPoliciesSuccess:
+		names := make([]string, 0, len(respCast.AlertPolicies))
+		for _, alertPolicy := range respCast.AlertPolicies {
+			names = append(names, alertPolicy.Name)
+		}
+
+		return names, nil
+	case *schema.ReconcileAlertPoliciesFromDocumentReconcileAlertPoliciesFromDocumentInvalidAlertPolicyError:
+		return []string{}, &types.ErrApi{Typename: respCast.Typename, Message: invalidAlertPolicyErrorMessage(respCast.InvalidAlertPolicyError)}
+	case *schema.ReconcileAlertPoliciesFromDocumentReconcileAlertPoliciesFromDocumentPythonError:
+		return []string{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.ReconcileAlertPoliciesFromDocumentReconcileAlertPoliciesFromDocumentUnauthorizedError:
+		return []string{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return []string{}, fmt.Errorf("unexpected type(%T) of result", resp.ReconcileAlertPoliciesFromDocument)
+	}
+}
+
+// SendSampleNotification sends a sample notification to the notification service of the alert policy in the document
+// and returns the message of the API. Failing to deliver the notification is returned as an error.
+func (c *AlertPoliciesClient) SendSampleNotification(ctx context.Context, document json.RawMessage) (string, error) {
+	alertPolicyDocument, err := UnwrapAlertPolicyDocument(document)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := schema.SendSampleNotification(ctx, c.client, alertPolicyDocument)
+	if err != nil {
+		return "", err
+	}
+
+	switch respCast := resp.SendSampleNotification.(type) {
+	case *schema.SendSampleNotificationSendSampleNotificationSendSampleNotificationSuccess:
+		return respCast.Message, nil
+	case *schema.SendSampleNotificationSendSampleNotificationSendSampleNotificationFailure:
+		return "", &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.SendSampleNotificationSendSampleNotificationInvalidAlertPolicyError:
+		return "", &types.ErrApi{Typename: respCast.Typename, Message: invalidAlertPolicyErrorMessage(respCast.InvalidAlertPolicyError)}
+	case *schema.SendSampleNotificationSendSampleNotificationPythonError:
+		return "", &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.SendSampleNotificationSendSampleNotificationUnauthorizedError:
+		return "", &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return "", fmt.Errorf("unexpected type(%T) of result", resp.SendSampleNotification)
+	}
+}
+
+// UnwrapAlertPolicyDocument returns the document of a single alert policy. Documents in the
+// `alert_policies: [...]` format of dagster-cloud are accepted as long as they contain exactly one alert policy.
+func UnwrapAlertPolicyDocument(document json.RawMessage) (json.RawMessage, error) {
+	var wrapped map[string]json.RawMessage
+	err := json.Unmarshal(document, &wrapped)
+	if err != nil {
+		return json.RawMessage{}, err
+	}
+
+	alertPoliciesRaw, ok := wrapped["alert_policies"]
+	if !ok {
+		return document, nil
+	}
+
+	var alertPolicies []json.RawMessage
+	err = json.Unmarshal(alertPoliciesRaw, &alertPolicies)
+	if err != nil {
+		return json.RawMessage{}, err
+	}
+
+	if len(alertPolicies) != 1 {
+		return json.RawMessage{}, &types.ErrInvalid{
+			What:    "AlertPolicyDocument",
+			Message: fmt.Sprintf("document must contain exactly one alert policy, got %d", len(alertPolicies)),
+		}
+	}
+
+	return alertPolicies[0], nil
+}
+
+// GetAlertPolicyFromDocument parses an alert policy document and validates its name and event types
+func GetAlertPolicyFromDocument(document json.RawMessage) (types.AlertPolicy, error) {
+	alertPolicyDocument, err := UnwrapAlertPolicyDocument(document)
+	if err != nil {
+		return types.AlertPolicy{}, err
+	}
+
+	var alertPolicy types.AlertPolicy
+	err = json.Unmarshal(alertPolicyDocument, &alertPolicy)
+	if err != nil {
+		return types.AlertPolicy{}, err
+	}
+
+	err = validateAlertPolicy(alertPolicy)
+	if err != nil {
+		return types.AlertPolicy{}, err
+	}
+
+	return alertPolicy, nil
+}
+
+// GetAlertPoliciesFromDocument parses a document in the `alert_policies: [...]` format and validates the alert policies in it
+func GetAlertPoliciesFromDocument(document json.RawMessage) ([]types.AlertPolicy, error) {
+	// A document without alert_policies would remove all alert policies, make sure it's not a single alert policy instead
+	var wrapped map[string]json.RawMessage
+	err := json.Unmarshal(document, &wrapped)
+	if err != nil {
+		return []types.AlertPolicy{}, err
+	}
+
+	if _, ok := wrapped["alert_policies"]; !ok {
+		return []types.AlertPolicy{}, &types.ErrInvalid{What: "AlertPoliciesDocument", Message: "alert_policies is missing"}
+	}
+
+	var alertPolicies types.AlertPoliciesAsDocumentResponse
+	err = json.Unmarshal(document, &alertPolicies)
+	if err != nil {
+		return []types.AlertPolicy{}, err
+	}
+
+	names := make(map[string]bool, len(alertPolicies.AlertPolicies))
+	for _, alertPolicy := range alertPolicies.AlertPolicies {
+		err = validateAlertPolicy(alertPolicy)
+		if err != nil {
+			return []types.AlertPolicy{}, err
+		}
+
+		if names[alertPolicy.Name] {
+			return []types.AlertPolicy{}, &types.ErrInvalid{
+				What:    "AlertPoliciesDocument",
+				Message: fmt.Sprintf("alert policy %s is defined more than once", alertPolicy.Name),
+			}
+		}
+		names[alertPolicy.Name] = true
+	}
+
+	return alertPolicies.AlertPolicies, nil
+}
+
+func validateAlertPolicy(alertPolicy types.AlertPolicy) error {
+	if alertPolicy.Name == "" {
+		return &types.ErrInvalid{What: "AlertPolicyDocument", Message: "name is missing"}
+	}
+
+	for _, eventType := range alertPolicy.EventTypes {
+		if utils.IndexOf(types.AlertPolicyEventTypeEnumValues(), eventType) == -1 {
+			return &types.ErrInvalid{
+				What: "AlertPolicyDocument",
+				Message: fmt.Sprintf(
+					"event type %s of alert policy %s is not one of %s",
+					eventType,
+					alertPolicy.Name,
+					strings.Join(types.AlertPolicyEventTypeEnumValues(), ", "),
+				),
+			}
+		}
+	}
+
+	return nil
+}
+
+func invalidAlertPolicyErrorMessage(err schema.InvalidAlertPolicyError) string {
+	if len(err.Errors) == 0 {
+		return err.Message
+	}
+
+	return fmt.Sprintf("%s: %s", err.Message, strings.Join(err.Errors, "; "))
+}
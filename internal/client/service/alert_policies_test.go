@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/service"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertPoliciesService_BasicCRUD(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars().AlertPoliciesClient
+	ctx := context.Background()
+
+	var errNotFound *types.ErrNotFound
+	alertPolicyName := "testing-alert-policy"
+
+	// Ensure no alert policy with the test name exists
+	_, err := client.GetAlertPolicyByName(ctx, alertPolicyName)
+	assert.ErrorAs(t, err, &errNotFound)
+
+	document := json.RawMessage(`{
+		"name": "testing-alert-policy",
+		"description": "Alert policy used in tests",
+		"event_types": ["JOB_FAILURE"],
+		"notification_service": {"email": {"email_addresses": ["test@example.com"]}},
+		"enabled": true
+	}`)
+
+	err = client.CreateOrUpdateAlertPolicyFromDocument(ctx, document)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.DeleteAlertPolicy(ctx, alertPolicyName)
+	})
+
+	alertPolicy, err := client.GetAlertPolicyByName(ctx, alertPolicyName)
+	require.NoError(t, err)
+	assert.Equal(t, "Alert policy used in tests", alertPolicy.Description)
+
+	alertPolicies, err := client.GetAllAlertPolicies(ctx)
+	assert.NoError(t, err)
+	assert.Condition(t, func() bool {
+		for _, alertPolicy := range alertPolicies {
+			if alertPolicy.Name == alertPolicyName {
+				return alertPolicy.Id != "" && alertPolicy.Enabled
+			}
+		}
+		return false
+	})
+	assert.Equal(t, []string{"JOB_FAILURE"}, alertPolicy.EventTypes)
+	assert.Equal(t, []string{"test@example.com"}, alertPolicy.NotificationService.Email.EmailAddresses)
+
+	// Documents in the dagster-cloud format are unwrapped
+	documentWrapped := json.RawMessage(`{"alert_policies": [{
+		"name": "testing-alert-policy",
+		"description": "Alert policy used in tests",
+		"event_types": ["JOB_FAILURE", "TICK_FAILURE"],
+		"notification_service": {"email": {"email_addresses": ["test@example.com"]}},
+		"enabled": false
+	}]}`)
+
+	err = client.CreateOrUpdateAlertPolicyFromDocument(ctx, documentWrapped)
+	require.NoError(t, err)
+
+	alertPolicyAsDocument, err := client.GetAlertPolicyAsDocumentByName(ctx, alertPolicyName)
+	require.NoError(t, err)
+
+	alertPolicy, err = service.GetAlertPolicyFromDocument(alertPolicyAsDocument)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"JOB_FAILURE", "TICK_FAILURE"}, alertPolicy.EventTypes)
+	assert.False(t, *alertPolicy.Enabled)
+
+	err = client.DeleteAlertPolicy(ctx, alertPolicyName)
+	assert.NoError(t, err)
+
+	// Ensure everything is cleaned up
+	_, err = client.GetAlertPolicyByName(ctx, alertPolicyName)
+	assert.ErrorAs(t, err, &errNotFound)
+
+	err = client.DeleteAlertPolicy(ctx, alertPolicyName)
+	assert.ErrorAs(t, err, &errNotFound)
+}
+
+func TestAlertPoliciesService_GetAlertPolicyFromDocument(t *testing.T) {
+	var errInvalid *types.ErrInvalid
+
+	_, err := service.GetAlertPolicyFromDocument(json.RawMessage(`{"name": "policy", "event_types": ["NOT_AN_EVENT"]}`))
+	assert.ErrorAs(t, err, &errInvalid)
+
+	_, err = service.GetAlertPolicyFromDocument(json.RawMessage(`{"event_types": ["JOB_FAILURE"]}`))
+	assert.ErrorAs(t, err, &errInvalid)
+
+	_, err = service.GetAlertPolicyFromDocument(json.RawMessage(`{"alert_policies": [{"name": "a"}, {"name": "b"}]}`))
+	assert.ErrorAs(t, err, &errInvalid)
+
+	alertPolicy, err := service.GetAlertPolicyFromDocument(json.RawMessage(`{"alert_policies": [{"name": "a", "event_types": ["JOB_SUCCESS"]}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "a", alertPolicy.Name)
+
+	// A single alert policy is not accepted where all alert policies are expected
+	_, err = service.GetAlertPoliciesFromDocument(json.RawMessage(`{"name": "a", "event_types": ["JOB_SUCCESS"]}`))
+	assert.ErrorAs(t, err, &errInvalid)
+
+	_, err = service.GetAlertPoliciesFromDocument(json.RawMessage(`{"alert_policies": [{"name": "a"}, {"name": "a"}]}`))
+	assert.ErrorAs(t, err, &errInvalid)
+
+	alertPolicies, err := service.GetAlertPoliciesFromDocument(json.RawMessage(`{"alert_policies": [{"name": "a"}, {"name": "b"}]}`))
+	assert.NoError(t, err)
+	assert.Len(t, alertPolicies, 2)
+}
+
+func TestAlertPoliciesService_Reconcile(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars().AlertPoliciesClient
+	ctx := context.Background()
+
+	var errNotFound *types.ErrNotFound
+
+	t.Cleanup(func() {
+		_, _ = client.ReconcileAlertPoliciesFromDocument(ctx, json.RawMessage(`{"alert_policies": []}`))
+	})
+
+	document := json.RawMessage(`{"alert_policies": [
+		{
+			"name": "testing-reconcile-1",
+			"description": "",
+			"event_types": ["JOB_FAILURE"],
+			"notification_service": {"email": {"email_addresses": ["test@example.com"]}}
+		},
+		{
+			"name": "testing-reconcile-2",
+			"description": "",
+			"event_types": ["AGENT_UNAVAILABLE"],
+			"notification_service": {"email": {"email_addresses": ["test@example.com"]}}
+		}
+	]}`)
+
+	names, err := client.ReconcileAlertPoliciesFromDocument(ctx, document)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"testing-reconcile-1", "testing-reconcile-2"}, names)
+
+	alertPolicies, err := client.ListAlertPolicies(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, alertPolicies, 2)
+
+	// Reconciling a subset removes the other alert policies
+	document = json.RawMessage(`{"alert_policies": [
+		{
+			"name": "testing-reconcile-1",
+			"description": "",
+			"event_types": ["JOB_FAILURE"],
+			"notification_service": {"email": {"email_addresses": ["test@example.com"]}}
+		}
+	]}`)
+
+	_, err = client.ReconcileAlertPoliciesFromDocument(ctx, document)
+	assert.NoError(t, err)
+
+	_, err = client.GetAlertPolicyByName(ctx, "testing-reconcile-2")
+	assert.ErrorAs(t, err, &errNotFound)
+
+	_, err = client.ReconcileAlertPoliciesFromDocument(ctx, json.RawMessage(`{"alert_policies": []}`))
+	assert.NoError(t, err)
+
+	alertPolicies, err = client.ListAlertPolicies(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, alertPolicies, 0)
+}
+
+func TestAlertPoliciesService_SendSampleNotification(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars().AlertPoliciesClient
+	ctx := context.Background()
+
+	document := json.RawMessage(`{
+		"name": "testing-sample-notification",
+		"description": "",
+		"event_types": ["JOB_FAILURE"],
+		"notification_service": {"email": {"email_addresses": ["test@example.com"]}}
+	}`)
+
+	message, err := client.SendSampleNotification(ctx, document)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, message)
+
+	var errApi *types.ErrApi
+	document = json.RawMessage(`{
+		"name": "testing-sample-notification",
+		"description": "",
+		"event_types": ["JOB_FAILURE"],
+		"notification_service": {"slack": {"slack_workspace_name": "does-not-exist", "slack_channel_name": "does-not-exist"}}
+	}`)
+
+	_, err = client.SendSampleNotification(ctx, document)
+	assert.ErrorAs(t, err, &errApi)
+}
+
+func TestAlertPoliciesService_GetAvailableSlackChannels(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars().AlertPoliciesClient
+	ctx := context.Background()
+
+	_, err := client.GetAvailableSlackChannels(ctx)
+	assert.NoError(t, err)
+}
@@ -12,12 +12,16 @@ import (
 )
 
 type CodeLocationsClient struct {
-	client graphql.Client
+	client          graphql.Client
+	locationSchema  *configSchemaCache
+	workspaceSchema *configSchemaCache
 }
 
 func NewCodeLocationsClient(client graphql.Client) CodeLocationsClient {
 	return CodeLocationsClient{
-		client: client,
+		client:          client,
+		locationSchema:  &configSchemaCache{},
+		workspaceSchema: &configSchemaCache{},
 	}
 }
 
@@ -274,3 +278,67 @@ func (c *CodeLocationsClient) GetCodeLocationAsDocumentByName(ctx context.Contex
 
 	return json.RawMessage{}, &types.ErrNotFound{What: "CodeLocationAsDocument", Key: "name", Value: name}
 }
+
+// GetLocationSchema retrieves the config schema of a single code location document, it's only fetched once per client
+func (c *CodeLocationsClient) GetLocationSchema(ctx context.Context) (types.ConfigSchema, error) {
+	return c.locationSchema.get(ctx, func(ctx context.Context) (types.ConfigSchema, error) {
+		resp, err := schema.GetLocationSchema(ctx, c.client)
+		if err != nil {
+			return types.ConfigSchema{}, err
+		}
+
+		locationSchema := resp.LocationSchema
+		if locationSchema.RootConfigType == nil {
+			return types.ConfigSchema{}, nil
+		}
+
+		return configSchemaFromTypes(locationSchema.RootConfigType.GetKey(), locationSchema.AllConfigTypes), nil
+	})
+}
+
+// GetWorkspaceSchema retrieves the config schema of a document with multiple code locations, it's only fetched once per client
+func (c *CodeLocationsClient) GetWorkspaceSchema(ctx context.Context) (types.ConfigSchema, error) {
+	return c.workspaceSchema.get(ctx, func(ctx context.Context) (types.ConfigSchema, error) {
+		resp, err := schema.GetWorkspaceSchema(ctx, c.client)
+		if err != nil {
+			return types.ConfigSchema{}, err
+		}
+
+		workspaceSchema := resp.WorkspaceSchema
+		if workspaceSchema.RootConfigType == nil {
+			return types.ConfigSchema{}, nil
+		}
+
+		return configSchemaFromTypes(workspaceSchema.RootConfigType.GetKey(), workspaceSchema.AllConfigTypes), nil
+	})
+}
+
+// ValidateCodeLocationDocument checks a single code location document against the location schema
+func (c *CodeLocationsClient) ValidateCodeLocationDocument(ctx context.Context, codeLocationDocument json.RawMessage) ([]types.ConfigError, error) {
+	var document interface{}
+	if err := json.Unmarshal(codeLocationDocument, &document); err != nil {
+		return nil, &types.ErrInvalid{What: "code location document", Message: err.Error()}
+	}
+
+	locationSchema, err := c.GetLocationSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return locationSchema.Validate(document, false), nil
+}
+
+// ValidateWorkspaceDocument checks a document in the `locations: [...]` format against the workspace schema
+func (c *CodeLocationsClient) ValidateWorkspaceDocument(ctx context.Context, workspaceDocument json.RawMessage) ([]types.ConfigError, error) {
+	var document interface{}
+	if err := json.Unmarshal(workspaceDocument, &document); err != nil {
+		return nil, &types.ErrInvalid{What: "workspace document", Message: err.Error()}
+	}
+
+	workspaceSchema, err := c.GetWorkspaceSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return workspaceSchema.Validate(document, false), nil
+}
@@ -169,3 +169,37 @@ func TestCodeLocationService_FromDocument_Errors(t *testing.T) {
 	err = client.AddCodeLocationFromDocument(ctx, errorInputMalformedJSON)
 	assert.ErrorContains(t, err, "invalid")
 }
+
+func TestCodeLocationService_ValidateDocument(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars().CodeLocationsClient
+	ctx := context.Background()
+
+	configErrors, err := client.ValidateCodeLocationDocument(ctx, json.RawMessage(`{
+		"location_name": "testing-codelocation-as-doc",
+		"code_source": {"python_file": "my_file.py"},
+		"image": "my_image:first"
+	}`))
+	assert.NoError(t, err)
+	assert.Empty(t, configErrors)
+
+	configErrors, err = client.ValidateCodeLocationDocument(ctx, json.RawMessage(`{
+		"location_name": "testing-codelocation-as-doc",
+		"code_source": {"python_file": "my_file.py"},
+		"image": 123
+	}`))
+	assert.NoError(t, err)
+	if assert.Len(t, configErrors, 1) {
+		assert.Equal(t, "image", configErrors[0].Path)
+	}
+
+	configErrors, err = client.ValidateWorkspaceDocument(ctx, json.RawMessage(`{"locations": [
+		{"location_name": "a", "code_source": {"python_file": "a.py"}},
+		{"location_name": "b", "code_sourc": {"python_file": "b.py"}}
+	]}`))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, configErrors)
+
+	var errInvalid *types.ErrInvalid
+	_, err = client.ValidateCodeLocationDocument(ctx, json.RawMessage(`{"location_name": `))
+	assert.ErrorAs(t, err, &errInvalid)
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+)
+
+// configSchemaCache fetches a config schema and shares it between copies of a client. Only successful fetches are
+// cached, so a failed fetch is retried by the next caller.
+type configSchemaCache struct {
+	mu     sync.Mutex
+	schema *types.ConfigSchema
+}
+
+func (c *configSchemaCache) get(ctx context.Context, fetch func(ctx context.Context) (types.ConfigSchema, error)) (types.ConfigSchema, error) {
+	// Clients that are not created with their constructor, like before the provider is configured, have no schema
+	if c == nil {
+		return types.ConfigSchema{}, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.schema != nil {
+		return *c.schema, nil
+	}
+
+	configSchema, err := fetch(ctx)
+	if err != nil {
+		return types.ConfigSchema{}, err
+	}
+
+	c.schema = &configSchema
+	return configSchema, nil
+}
+
+// configSchemaFromTypes converts the config types of a schema, indexed by their key
+func configSchemaFromTypes(rootKey string, configTypes []schema.ConfigSchemaType) types.ConfigSchema {
+	configSchema := types.ConfigSchema{
+		RootKey: rootKey,
+		Types:   make(map[string]types.ConfigType, len(configTypes)),
+	}
+
+	for _, configType := range configTypes {
+		converted := types.ConfigType{
+			Kind:          configType.GetTypename(),
+			Key:           configType.GetKey(),
+			IsSelector:    configType.GetIsSelector(),
+			TypeParamKeys: configType.GetTypeParamKeys(),
+		}
+
+		switch configTypeCast := configType.(type) {
+		case *schema.ConfigSchemaTypeCompositeConfigType:
+			for _, field := range configTypeCast.Fields {
+				converted.Fields = append(converted.Fields, types.ConfigField{
+					Name:       field.Name,
+					TypeKey:    field.ConfigTypeKey,
+					IsRequired: field.IsRequired,
+				})
+			}
+		case *schema.ConfigSchemaTypeEnumConfigType:
+			converted.GivenName = configTypeCast.GivenName
+			for _, value := range configTypeCast.Values {
+				converted.EnumValues = append(converted.EnumValues, value.Value)
+			}
+		case *schema.ConfigSchemaTypeRegularConfigType:
+			converted.GivenName = configTypeCast.GivenName
+		case *schema.ConfigSchemaTypeScalarUnionConfigType:
+			converted.ScalarTypeKey = configTypeCast.ScalarTypeKey
+			converted.NonScalarTypeKey = configTypeCast.NonScalarTypeKey
+		}
+
+		configSchema.Types[converted.Key] = converted
+	}
+
+	return configSchema
+}
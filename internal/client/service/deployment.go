@@ -13,12 +13,14 @@ import (
 )
 
 type DeploymentClient struct {
-	client graphql.Client
+	client         graphql.Client
+	settingsSchema *configSchemaCache
 }
 
 func NewDeploymentClient(client graphql.Client) DeploymentClient {
 	return DeploymentClient{
-		client: client,
+		client:         client,
+		settingsSchema: &configSchemaCache{},
 	}
 }
 
@@ -75,30 +77,51 @@ func (c DeploymentClient) GetDeploymentById(ctx context.Context, id int) (schema
 	return schema.Deployment{}, &types.ErrNotFound{What: "deployment", Key: "name", Value: strconv.Itoa(id)}
 }
 
-func (c DeploymentClient) CreateHybridDeployment(ctx context.Context, name string) (schema.Deployment, error) {
-	resp, err := schema.CreateHybridDeployment(ctx, c.client, name)
+// CreateDeployment creates a deployment, which inherits the permissions of the deployment with id inheritPermsDeploymentId unless it is 0
+func (c DeploymentClient) CreateDeployment(ctx context.Context, name string, agentType schema.DeploymentAgentType, inheritPermsDeploymentId int) (schema.Deployment, error) {
+	resp, err := schema.CreateDeployment(ctx, c.client, name, agentType, inheritPermsDeploymentId)
 	if err != nil {
 		return schema.Deployment{}, fmt.Errorf("unable to create deployment %s: %w", name, err)
 	}
 
 	switch respCast := resp.CreateDeployment.(type) {
-	case *schema.CreateHybridDeploymentCreateDeploymentDagsterCloudDeployment:
+	case *schema.CreateDeploymentCreateDeploymentDagsterCloudDeployment:
 		return respCast.Deployment, nil
-	case *schema.CreateHybridDeploymentCreateDeploymentDeploymentLimitError:
+	case *schema.CreateDeploymentCreateDeploymentDeploymentLimitError:
 		return schema.Deployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
-	case *schema.CreateHybridDeploymentCreateDeploymentDeploymentNotFoundError:
+	case *schema.CreateDeploymentCreateDeploymentDeploymentNotFoundError:
 		return schema.Deployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
-	case *schema.CreateHybridDeploymentCreateDeploymentPythonError:
+	case *schema.CreateDeploymentCreateDeploymentPythonError:
 		return schema.Deployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
-	case *schema.CreateHybridDeploymentCreateDeploymentDuplicateDeploymentError:
+	case *schema.CreateDeploymentCreateDeploymentDuplicateDeploymentError:
 		return schema.Deployment{}, &types.ErrAlreadyExists{What: "deployment", Key: "name", Value: name}
-	case *schema.CreateHybridDeploymentCreateDeploymentUnauthorizedError:
+	case *schema.CreateDeploymentCreateDeploymentUnauthorizedError:
 		return schema.Deployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
 	default:
 		return schema.Deployment{}, fmt.Errorf("unexpected type(%T) of result", resp.CreateDeployment)
 	}
 }
 
+func (c DeploymentClient) UpdateDeploymentAgentType(ctx context.Context, id int, agentType schema.DeploymentAgentType) (schema.Deployment, error) {
+	resp, err := schema.UpdateDeploymentAgentType(ctx, c.client, id, agentType)
+	if err != nil {
+		return schema.Deployment{}, fmt.Errorf("unable to update agent type of deployment %v: %w", id, err)
+	}
+
+	switch respCast := resp.UpdateDeploymentAgentType.(type) {
+	case *schema.UpdateDeploymentAgentTypeUpdateDeploymentAgentTypeDagsterCloudDeployment:
+		return respCast.Deployment, nil
+	case *schema.UpdateDeploymentAgentTypeUpdateDeploymentAgentTypeDeploymentNotFoundError:
+		return schema.Deployment{}, &types.ErrNotFound{What: "deployment", Key: "id", Value: strconv.Itoa(id)}
+	case *schema.UpdateDeploymentAgentTypeUpdateDeploymentAgentTypePythonError:
+		return schema.Deployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.UpdateDeploymentAgentTypeUpdateDeploymentAgentTypeUnauthorizedError:
+		return schema.Deployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.Deployment{}, fmt.Errorf("unexpected type(%T) of result", resp.UpdateDeploymentAgentType)
+	}
+}
+
 func (c DeploymentClient) DeleteDeployment(ctx context.Context, id int) error {
 	resp, err := schema.DeleteDeployment(ctx, c.client, id)
 	if err != nil {
@@ -148,3 +171,176 @@ func (c DeploymentClient) SetDeploymentSettings(ctx context.Context, deploymentI
 		return nil, fmt.Errorf("unexpected type(%T) of result", resp.SetDeploymentSettings)
 	}
 }
+
+// UpdateDeploymentSettings merges the given settings into the existing settings of a deployment
+func (c DeploymentClient) UpdateDeploymentSettings(ctx context.Context, deploymentId int, settings json.RawMessage) (json.RawMessage, error) {
+	settingsInput := schema.DeploymentSettingsInput{
+		Settings: settings,
+	}
+	resp, err := schema.UpdateDeploymentSettings(ctx, c.client, deploymentId, settingsInput)
+	if err != nil {
+		tflog.Trace(ctx, fmt.Sprintf("Unable to update deployment settings: %v", err.Error()))
+		return nil, fmt.Errorf("unable to update deployment settings: %w", err)
+	}
+
+	switch respCast := resp.UpdateDeploymentSettings.(type) {
+	case *schema.UpdateDeploymentSettingsUpdateDeploymentSettings:
+		return respCast.Settings, nil
+	case *schema.UpdateDeploymentSettingsUpdateDeploymentSettingsDeleteFinalDeploymentError:
+		return nil, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.UpdateDeploymentSettingsUpdateDeploymentSettingsDeploymentNotFoundError:
+		return nil, &types.ErrNotFound{What: "deployment", Key: "id", Value: strconv.Itoa(deploymentId)}
+	case *schema.UpdateDeploymentSettingsUpdateDeploymentSettingsDuplicateDeploymentError:
+		return nil, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.UpdateDeploymentSettingsUpdateDeploymentSettingsPythonError:
+		return nil, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.UpdateDeploymentSettingsUpdateDeploymentSettingsUnauthorizedError:
+		return nil, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return nil, fmt.Errorf("unexpected type(%T) of result", resp.UpdateDeploymentSettings)
+	}
+}
+
+// GetDeploymentSettingsSchema retrieves the config schema of the deployment settings, it's only fetched once per client
+func (c DeploymentClient) GetDeploymentSettingsSchema(ctx context.Context) (types.ConfigSchema, error) {
+	return c.settingsSchema.get(ctx, func(ctx context.Context) (types.ConfigSchema, error) {
+		resp, err := schema.GetDeploymentSettingsSchema(ctx, c.client)
+		if err != nil {
+			return types.ConfigSchema{}, err
+		}
+
+		settingsSchema := resp.DeploymentSettingsSchema
+		if settingsSchema.RootConfigType == nil {
+			return types.ConfigSchema{}, nil
+		}
+
+		return configSchemaFromTypes(settingsSchema.RootConfigType.GetKey(), settingsSchema.AllConfigTypes), nil
+	})
+}
+
+// ValidateDeploymentSettings checks a settings document against the deployment settings schema, partial documents may leave out required settings
+func (c DeploymentClient) ValidateDeploymentSettings(ctx context.Context, settings json.RawMessage, partial bool) ([]types.ConfigError, error) {
+	var document interface{}
+	if err := json.Unmarshal(settings, &document); err != nil {
+		return nil, &types.ErrInvalid{What: "deployment settings", Message: err.Error()}
+	}
+
+	settingsSchema, err := c.GetDeploymentSettingsSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return settingsSchema.Validate(document, partial), nil
+}
+
+// branchDeploymentsLimit is the number of branch deployments retrieved at once, the API has no cursor
+// so the limit is doubled until fewer branch deployments than the limit are returned
+const branchDeploymentsLimit = 1000
+
+// GetBranchDeployments retrieves all branch deployments, optionally filtered by the status of their pull request.
+// Returns an error when the API returns fewer branch deployments than the deployment list holds.
+func (c DeploymentClient) GetBranchDeployments(ctx context.Context, pullRequestStatus *schema.PullRequestStatus) ([]schema.BranchDeployment, error) {
+	var nodes []schema.ListBranchDeploymentsBranchDeploymentsBranchDeploymentsConnectionNodesDagsterCloudDeployment
+	for limit := branchDeploymentsLimit; ; limit *= 2 {
+		resp, err := schema.ListBranchDeployments(ctx, c.client, limit)
+		if err != nil {
+			return []schema.BranchDeployment{}, err
+		}
+
+		nodes = resp.BranchDeployments.Nodes
+		if len(nodes) < limit {
+			break
+		}
+	}
+
+	// A server side cap below the limit can't be told apart from the last page, so the number of branch deployments
+	// is checked against the list of all deployments
+	allDeployments, err := c.GetAllDeployments(ctx)
+	if err != nil {
+		return []schema.BranchDeployment{}, err
+	}
+
+	branchDeploymentCount := 0
+	for _, deployment := range allDeployments {
+		if deployment.DeploymentType == schema.DagsterCloudDeploymentTypeBranch {
+			branchDeploymentCount++
+		}
+	}
+
+	if len(nodes) < branchDeploymentCount {
+		return []schema.BranchDeployment{}, fmt.Errorf("only %d of %d branch deployments were returned", len(nodes), branchDeploymentCount)
+	}
+
+	deployments := make([]schema.BranchDeployment, 0, len(nodes))
+	for _, deployment := range nodes {
+		gitMetadata := deployment.BranchDeploymentGitMetadata
+		if pullRequestStatus != nil && gitMetadata.PullRequestStatus != *pullRequestStatus {
+			continue
+		}
+		deployments = append(deployments, deployment.BranchDeployment)
+	}
+
+	return deployments, nil
+}
+
+func (c DeploymentClient) GetBranchDeploymentById(ctx context.Context, id int) (schema.BranchDeployment, error) {
+	deployments, err := c.GetBranchDeployments(ctx, nil)
+	if err != nil {
+		return schema.BranchDeployment{}, err
+	}
+
+	for _, deploy := range deployments {
+		if deploy.DeploymentId == id {
+			return deploy, nil
+		}
+	}
+
+	return schema.BranchDeployment{}, &types.ErrNotFound{What: "branch deployment", Key: "id", Value: strconv.Itoa(id)}
+}
+
+// GetBranchDeploymentByName retrieves a single branch deployment without listing all branch deployments
+func (c DeploymentClient) GetBranchDeploymentByName(ctx context.Context, name string) (schema.BranchDeployment, error) {
+	resp, err := schema.GetBranchDeploymentByName(ctx, c.client, name)
+	if err != nil {
+		return schema.BranchDeployment{}, err
+	}
+
+	switch respCast := resp.DeploymentByName.(type) {
+	case *schema.GetBranchDeploymentByNameDeploymentByNameDagsterCloudDeployment:
+		if !respCast.IsBranchDeployment {
+			return schema.BranchDeployment{}, &types.ErrNotFound{What: "branch deployment", Key: "name", Value: name}
+		}
+		return respCast.BranchDeployment, nil
+	case *schema.GetBranchDeploymentByNameDeploymentByNameDeploymentNotFoundError:
+		return schema.BranchDeployment{}, &types.ErrNotFound{What: "branch deployment", Key: "name", Value: name}
+	case *schema.GetBranchDeploymentByNameDeploymentByNamePythonError:
+		return schema.BranchDeployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.BranchDeployment{}, fmt.Errorf("unexpected type(%T) of result", resp.DeploymentByName)
+	}
+}
+
+// CreateOrUpdateBranchDeployment creates the branch deployment of a repository branch, or updates it when it already exists
+func (c DeploymentClient) CreateOrUpdateBranchDeployment(ctx context.Context, branchData schema.CreateOrUpdateBranchDeploymentInput, commit schema.DeploymentCommitInput) (schema.BranchDeployment, error) {
+	resp, err := schema.CreateOrUpdateBranchDeployment(ctx, c.client, branchData, commit)
+	if err != nil {
+		return schema.BranchDeployment{}, fmt.Errorf("unable to create or update branch deployment for %s/%s: %w", branchData.RepoName, branchData.BranchName, err)
+	}
+
+	switch respCast := resp.CreateOrUpdateBranchDeployment.(type) {
+	case *schema.CreateOrUpdateBranchDeploymentCreateOrUpdateBranchDeploymentDagsterCloudDeployment:
+		return respCast.BranchDeployment, nil
+	case *schema.CreateOrUpdateBranchDeploymentCreateOrUpdateBranchDeploymentDeploymentLimitError:
+		return schema.BranchDeployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateOrUpdateBranchDeploymentCreateOrUpdateBranchDeploymentDeploymentNotFoundError:
+		return schema.BranchDeployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateOrUpdateBranchDeploymentCreateOrUpdateBranchDeploymentDuplicateDeploymentError:
+		return schema.BranchDeployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateOrUpdateBranchDeploymentCreateOrUpdateBranchDeploymentPythonError:
+		return schema.BranchDeployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateOrUpdateBranchDeploymentCreateOrUpdateBranchDeploymentUnauthorizedError:
+		return schema.BranchDeployment{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.BranchDeployment{}, fmt.Errorf("unexpected type(%T) of result", resp.CreateOrUpdateBranchDeployment)
+	}
+}
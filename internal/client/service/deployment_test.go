@@ -2,9 +2,12 @@ package service_test
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
 	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
 	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
 	"github.com/stretchr/testify/assert"
@@ -24,9 +27,10 @@ func TestDeploymentService_BasicCRUD(t *testing.T) {
 	assert.ErrorAs(t, err, &errNotFound)
 
 	// Create deployment
-	deploymentCreated, err := deploymentClient.CreateHybridDeployment(ctx, deploymentName)
+	deploymentCreated, err := deploymentClient.CreateDeployment(ctx, deploymentName, schema.DeploymentAgentTypeHybrid, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, deploymentName, deploymentCreated.DeploymentName, "Expected deployment names to be the same.")
+	assert.Equal(t, schema.DeploymentAgentTypeHybrid, deploymentCreated.AgentType)
 
 	t.Cleanup(func() {
 		_ = deploymentClient.DeleteDeployment(ctx, deploymentCreated.DeploymentId)
@@ -41,6 +45,12 @@ func TestDeploymentService_BasicCRUD(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, deploymentName, teamByName.DeploymentName, "Expected team names to be the same.")
 
+	// Switch the agent type in place
+	deploymentUpdated, err := deploymentClient.UpdateDeploymentAgentType(ctx, deploymentCreated.DeploymentId, schema.DeploymentAgentTypeServerless)
+	assert.NoError(t, err)
+	assert.Equal(t, deploymentCreated.DeploymentId, deploymentUpdated.DeploymentId)
+	assert.Equal(t, schema.DeploymentAgentTypeServerless, deploymentUpdated.AgentType)
+
 	// Delete deployment
 	err = deploymentClient.DeleteDeployment(ctx, deploymentCreated.DeploymentId)
 	assert.NoError(t, err)
@@ -64,7 +74,7 @@ func TestDeploymentSettingsCRUD(t *testing.T) {
 	assert.ErrorAs(t, err, &errNotFound)
 
 	// Create deployment
-	deployment, err := deploymentClient.CreateHybridDeployment(ctx, deploymentName)
+	deployment, err := deploymentClient.CreateDeployment(ctx, deploymentName, schema.DeploymentAgentTypeHybrid, 0)
 	assert.NoError(t, err)
 	settingsAtCreation := deployment.DeploymentSettings
 
@@ -89,6 +99,14 @@ func TestDeploymentSettingsCRUD(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, settingsToApply, settings)
 	assert.Equal(t, testutils.UnmarshalJSONOrPanic(settings)["sso_default_role"], "LAUNCHER")
+
+	// Merge "sso_default_role: EDITOR" into the existing settings
+	settings, err = deploymentClient.UpdateDeploymentSettings(ctx, deployment.DeploymentId, json.RawMessage(`{"sso_default_role": "EDITOR"}`))
+	assert.NoError(t, err)
+
+	settingsJSON = testutils.UnmarshalJSONOrPanic(settings)
+	assert.Equal(t, "EDITOR", settingsJSON["sso_default_role"])
+	assert.Equal(t, testutils.UnmarshalJSONOrPanic(settingsToApply)["run_queue"], settingsJSON["run_queue"])
 }
 
 func TestGetCurrentDeployment(t *testing.T) {
@@ -116,7 +134,7 @@ func TestCreateDeploymentAlreadyExists(t *testing.T) {
 	client := testutils.GetDagsterClientFromEnvVars()
 	ctx := context.Background()
 
-	_, err := client.DeploymentClient.CreateHybridDeployment(ctx, "prod")
+	_, err := client.DeploymentClient.CreateDeployment(ctx, "prod", schema.DeploymentAgentTypeHybrid, 0)
 	var errExists *types.ErrAlreadyExists
 	assert.ErrorAs(t, err, &errExists)
 }
@@ -125,6 +143,119 @@ func TestCreateDeploymentInvalidName(t *testing.T) {
 	client := testutils.GetDagsterClientFromEnvVars()
 	ctx := context.Background()
 
-	_, err := client.DeploymentClient.CreateHybridDeployment(ctx, "_%@")
+	_, err := client.DeploymentClient.CreateDeployment(ctx, "_%@", schema.DeploymentAgentTypeHybrid, 0)
 	assert.Error(t, err)
 }
+
+func TestCreateDeploymentInheritPermissions(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars()
+	ctx := context.Background()
+
+	deploymentName := "test-deployment-inherit-perms"
+
+	current, err := client.DeploymentClient.GetCurrentDeployment(ctx)
+	assert.NoError(t, err)
+
+	deployment, err := client.DeploymentClient.CreateDeployment(ctx, deploymentName, schema.DeploymentAgentTypeHybrid, current.DeploymentId)
+	assert.NoError(t, err)
+	assert.Equal(t, deploymentName, deployment.DeploymentName)
+
+	t.Cleanup(func() {
+		_ = client.DeploymentClient.DeleteDeployment(ctx, deployment.DeploymentId)
+	})
+}
+
+func TestBranchDeploymentCRUD(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars()
+	ctx := context.Background()
+	deploymentClient := client.DeploymentClient
+
+	var errNotFound *types.ErrNotFound
+
+	branchData := schema.CreateOrUpdateBranchDeploymentInput{
+		RepoName:          "dataroots/terraform-provider-dagster",
+		BranchName:        "test-branch-deployment",
+		PullRequestStatus: schema.PullRequestStatusOpen,
+		PullRequestNumber: "1",
+	}
+	commit := schema.DeploymentCommitInput{
+		CommitHash:    "0000000000000000000000000000000000000001",
+		Timestamp:     float64(time.Now().Unix()),
+		CommitMessage: "Initial commit",
+	}
+
+	deployment, err := deploymentClient.CreateOrUpdateBranchDeployment(ctx, branchData, commit)
+	assert.NoError(t, err)
+	assert.True(t, deployment.IsBranchDeployment)
+	assert.Equal(t, branchData.BranchName, deployment.BranchDeploymentGitMetadata.BranchName)
+
+	t.Cleanup(func() {
+		_ = deploymentClient.DeleteDeployment(ctx, deployment.DeploymentId)
+	})
+
+	// A new commit updates the same branch deployment
+	commit.CommitHash = "0000000000000000000000000000000000000002"
+	deploymentUpdated, err := deploymentClient.CreateOrUpdateBranchDeployment(ctx, branchData, commit)
+	assert.NoError(t, err)
+	assert.Equal(t, deployment.DeploymentId, deploymentUpdated.DeploymentId)
+	assert.Equal(t, commit.CommitHash, deploymentUpdated.LatestCommit.CommitHash)
+
+	deploymentRead, err := deploymentClient.GetBranchDeploymentById(ctx, deployment.DeploymentId)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.PullRequestStatusOpen, deploymentRead.BranchDeploymentGitMetadata.PullRequestStatus)
+
+	deploymentRead, err = deploymentClient.GetBranchDeploymentByName(ctx, deployment.DeploymentName)
+	assert.NoError(t, err)
+	assert.Equal(t, deployment.DeploymentId, deploymentRead.DeploymentId)
+
+	branchData.PullRequestStatus = schema.PullRequestStatusClosed
+	_, err = deploymentClient.CreateOrUpdateBranchDeployment(ctx, branchData, commit)
+	assert.NoError(t, err)
+
+	// Branch deployments are filtered by the status of their pull request
+	closedStatus, openStatus := schema.PullRequestStatusClosed, schema.PullRequestStatusOpen
+	closedDeployments, err := deploymentClient.GetBranchDeployments(ctx, &closedStatus)
+	assert.NoError(t, err)
+	assert.True(t, containsBranchDeployment(closedDeployments, deployment.DeploymentId))
+
+	openDeployments, err := deploymentClient.GetBranchDeployments(ctx, &openStatus)
+	assert.NoError(t, err)
+	assert.False(t, containsBranchDeployment(openDeployments, deployment.DeploymentId))
+
+	err = deploymentClient.DeleteDeployment(ctx, deployment.DeploymentId)
+	assert.NoError(t, err)
+
+	_, err = deploymentClient.GetBranchDeploymentById(ctx, deployment.DeploymentId)
+	assert.ErrorAs(t, err, &errNotFound)
+
+	_, err = deploymentClient.GetBranchDeploymentByName(ctx, deployment.DeploymentName)
+	assert.ErrorAs(t, err, &errNotFound)
+}
+
+func containsBranchDeployment(deployments []schema.BranchDeployment, id int) bool {
+	for _, deployment := range deployments {
+		if deployment.DeploymentId == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestValidateDeploymentSettings(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars()
+	ctx := context.Background()
+
+	settingsSchema, err := client.DeploymentClient.GetDeploymentSettingsSchema(ctx)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, settingsSchema.RootKey)
+
+	configErrors, err := client.DeploymentClient.ValidateDeploymentSettings(ctx, json.RawMessage(`{"run_queue": {"max_concurrent_runs": 10}}`), true)
+	assert.NoError(t, err)
+	assert.Empty(t, configErrors)
+
+	configErrors, err = client.DeploymentClient.ValidateDeploymentSettings(ctx, json.RawMessage(`{"run_queue": {"max_concurent_runs": 10}}`), true)
+	assert.NoError(t, err)
+	if assert.Len(t, configErrors, 1) {
+		assert.Equal(t, "run_queue.max_concurent_runs", configErrors[0].Path)
+	}
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+)
+
+type SecretsClient struct {
+	client graphql.Client
+}
+
+func NewSecretsClient(client graphql.Client) SecretsClient {
+	return SecretsClient{
+		client: client,
+	}
+}
+
+// ListSecrets retrieves all secrets of the deployment the client is configured with
+func (c *SecretsClient) ListSecrets(ctx context.Context) ([]schema.Secret, error) {
+	resp, err := schema.ListSecrets(ctx, c.client)
+	if err != nil {
+		return []schema.Secret{}, err
+	}
+
+	switch respCast := resp.SecretsOrError.(type) {
+	case *schema.ListSecretsSecretsOrErrorSecrets:
+		secrets := make([]schema.Secret, 0, len(respCast.Secrets))
+		for _, secret := range respCast.Secrets {
+			secrets = append(secrets, secret.Secret)
+		}
+
+		return secrets, nil
+	case *schema.ListSecretsSecretsOrErrorPythonError:
+		return []schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.ListSecretsSecretsOrErrorUnauthorizedError:
+		return []schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return []schema.Secret{}, fmt.Errorf("unexpected type(%T) of result", resp.SecretsOrError)
+	}
+}
+
+// GetSecretById looks up a secret by id and returns it
+func (c *SecretsClient) GetSecretById(ctx context.Context, id string) (schema.Secret, error) {
+	secrets, err := c.ListSecrets(ctx)
+	if err != nil {
+		return schema.Secret{}, err
+	}
+
+	for _, secret := range secrets {
+		if secret.Id == id {
+			return secret, nil
+		}
+	}
+
+	return schema.Secret{}, &types.ErrNotFound{What: "Secret", Key: "id", Value: id}
+}
+
+// GetSecretByName looks up a secret by name and returns it.
+// When several secrets share the same name (with different scopes), the first one is returned.
+func (c *SecretsClient) GetSecretByName(ctx context.Context, name string) (schema.Secret, error) {
+	secrets, err := c.ListSecrets(ctx)
+	if err != nil {
+		return schema.Secret{}, err
+	}
+
+	for _, secret := range secrets {
+		if secret.SecretName == name {
+			return secret, nil
+		}
+	}
+
+	return schema.Secret{}, &types.ErrNotFound{What: "Secret", Key: "name", Value: name}
+}
+
+// CreateSecret creates a secret with the given scopes, an empty list of locationNames makes the secret available to all code locations
+func (c *SecretsClient) CreateSecret(ctx context.Context, name string, value string, scopes schema.SecretScopesInput, locationNames []string) (schema.Secret, error) {
+	resp, err := schema.CreateSecret(ctx, c.client, locationNames, scopes, name, value)
+	if err != nil {
+		return schema.Secret{}, err
+	}
+
+	switch respCast := resp.CreateSecret.(type) {
+	case *schema.CreateSecretCreateSecretCreateOrUpdateSecretSuccess:
+		return respCast.Secret.Secret, nil
+	case *schema.CreateSecretCreateSecretInvalidSecretInputError:
+		return schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateSecretCreateSecretPythonError:
+		return schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateSecretCreateSecretTooManySecretsError:
+		return schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateSecretCreateSecretUnauthorizedError:
+		return schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.Secret{}, fmt.Errorf("unexpected type(%T) of result", resp.CreateSecret)
+	}
+}
+
+// UpdateSecret updates the name, value, scopes and code locations of an existing secret
+func (c *SecretsClient) UpdateSecret(ctx context.Context, id string, name string, value string, scopes schema.SecretScopesInput, locationNames []string) (schema.Secret, error) {
+	_, err := c.GetSecretById(ctx, id)
+	if err != nil {
+		return schema.Secret{}, err
+	}
+
+	resp, err := schema.UpdateSecret(ctx, c.client, locationNames, scopes, id, name, value)
+	if err != nil {
+		return schema.Secret{}, err
+	}
+
+	switch respCast := resp.UpdateSecret.(type) {
+	case *schema.UpdateSecretUpdateSecretCreateOrUpdateSecretSuccess:
+		return respCast.Secret.Secret, nil
+	case *schema.UpdateSecretUpdateSecretInvalidSecretInputError:
+		return schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.UpdateSecretUpdateSecretPythonError:
+		return schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.UpdateSecretUpdateSecretTooManySecretsError:
+		return schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.UpdateSecretUpdateSecretUnauthorizedError:
+		return schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.Secret{}, fmt.Errorf("unexpected type(%T) of result", resp.UpdateSecret)
+	}
+}
+
+// DeleteSecret deletes a secret by id
+func (c *SecretsClient) DeleteSecret(ctx context.Context, id string) error {
+	_, err := c.GetSecretById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	resp, err := schema.DeleteSecret(ctx, c.client, id)
+	if err != nil {
+		return err
+	}
+
+	switch respCast := resp.DeleteSecret.(type) {
+	case *schema.DeleteSecretDeleteSecretDeleteSecretSuccess:
+		return nil
+	case *schema.DeleteSecretDeleteSecretPythonError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.DeleteSecretDeleteSecretUnauthorizedError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return fmt.Errorf("unexpected type(%T) of result", resp.DeleteSecret)
+	}
+}
+
+// SyncSecrets replaces all secrets of the deployment with the given secrets, secrets that are not in the list are removed
+func (c *SecretsClient) SyncSecrets(ctx context.Context, secrets []schema.SecretInput) ([]schema.Secret, error) {
+	resp, err := schema.SyncSecrets(ctx, c.client, secrets)
+	if err != nil {
+		return []schema.Secret{}, err
+	}
+
+	switch respCast := resp.SyncSecrets.(type) {
+	case *schema.SyncSecretsSyncSecretsSyncSecretsSuccess:
+		syncedSecrets := make([]schema.Secret, 0, len(respCast.Secrets))
+		for _, secret := range respCast.Secrets {
+			syncedSecrets = append(syncedSecrets, secret.Secret)
+		}
+
+		return syncedSecrets, nil
+	case *schema.SyncSecretsSyncSecretsInvalidSecretInputError:
+		return []schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.SyncSecretsSyncSecretsPythonError:
+		return []schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.SyncSecretsSyncSecretsTooManySecretsError:
+		return []schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.SyncSecretsSyncSecretsUnauthorizedError:
+		return []schema.Secret{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return []schema.Secret{}, fmt.Errorf("unexpected type(%T) of result", resp.SyncSecrets)
+	}
+}
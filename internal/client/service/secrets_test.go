@@ -0,0 +1,154 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/service"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretsService_BasicCRUD(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars().SecretsClient
+	ctx := context.Background()
+
+	var errNotFound *types.ErrNotFound
+	secretName := "TESTING_SECRET"
+	secretNameRenamed := "TESTING_SECRET_RENAMED"
+
+	// Ensure no secrets with the test names exist
+	_, err := client.GetSecretByName(ctx, secretName)
+	assert.ErrorAs(t, err, &errNotFound)
+
+	_, err = client.GetSecretByName(ctx, secretNameRenamed)
+	assert.ErrorAs(t, err, &errNotFound)
+
+	scopes := schema.SecretScopesInput{
+		FullDeploymentScope: true,
+	}
+
+	secretCreated, err := client.CreateSecret(ctx, secretName, "my_value", scopes, []string{})
+	assert.NoError(t, err)
+	assert.Equal(t, secretName, secretCreated.SecretName)
+	assert.True(t, secretCreated.FullDeploymentScope)
+	assert.False(t, secretCreated.AllBranchDeploymentsScope)
+
+	t.Cleanup(func() {
+		_ = client.DeleteSecret(ctx, secretCreated.Id)
+	})
+
+	secretById, err := client.GetSecretById(ctx, secretCreated.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, secretName, secretById.SecretName)
+
+	secretByName, err := client.GetSecretByName(ctx, secretName)
+	assert.NoError(t, err)
+	assert.Equal(t, secretCreated.Id, secretByName.Id)
+
+	// Rename secret and change its scopes
+	scopes.AllBranchDeploymentsScope = true
+	secretUpdated, err := client.UpdateSecret(ctx, secretCreated.Id, secretNameRenamed, "my_other_value", scopes, []string{})
+	assert.NoError(t, err)
+	assert.Equal(t, secretNameRenamed, secretUpdated.SecretName)
+	assert.True(t, secretUpdated.AllBranchDeploymentsScope)
+
+	err = client.DeleteSecret(ctx, secretUpdated.Id)
+	assert.NoError(t, err)
+
+	// Ensure everything is cleaned up
+	_, err = client.GetSecretById(ctx, secretUpdated.Id)
+	assert.ErrorAs(t, err, &errNotFound)
+
+	err = client.DeleteSecret(ctx, secretUpdated.Id)
+	assert.ErrorAs(t, err, &errNotFound)
+}
+
+func TestSecretsService_Sync(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars().SecretsClient
+	ctx := context.Background()
+
+	// Syncing replaces all secrets of the deployment, so the existing secrets are synced along
+	existingSecrets, err := client.ListSecrets(ctx)
+	assert.NoError(t, err)
+
+	existingInputs := make([]schema.SecretInput, 0, len(existingSecrets))
+	for _, secret := range existingSecrets {
+		if !secret.CanViewSecretValue {
+			t.Skipf("secret %s can't be viewed and would be lost by syncing", secret.SecretName)
+		}
+		existingInputs = append(existingInputs, secretInputFromSecret(secret))
+	}
+
+	scopes := schema.SecretScopesInput{
+		FullDeploymentScope: true,
+	}
+
+	suffix := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	secretNames := []string{"TESTING_SYNC_SECRET_1_" + suffix, "TESTING_SYNC_SECRET_2_" + suffix}
+	secretInputs := []schema.SecretInput{
+		{Scopes: scopes, SecretName: secretNames[0], SecretValue: "value_1", LocationNames: []string{}},
+		{Scopes: scopes, SecretName: secretNames[1], SecretValue: "value_2", LocationNames: []string{}},
+	}
+
+	t.Cleanup(func() {
+		for _, secretName := range secretNames {
+			if secret, err := client.GetSecretByName(ctx, secretName); err == nil {
+				_ = client.DeleteSecret(ctx, secret.Id)
+			}
+		}
+	})
+
+	_, err = client.SyncSecrets(ctx, append(existingInputs, secretInputs...))
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, secretNames, listSecretNames(t, client, secretNames))
+
+	// Syncing a subset removes the other secrets
+	_, err = client.SyncSecrets(ctx, append(existingInputs, secretInputs[:1]...))
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, secretNames[:1], listSecretNames(t, client, secretNames))
+
+	// Existing secrets are left in place
+	secrets, err := client.ListSecrets(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, secrets, len(existingSecrets)+1)
+}
+
+// listSecretNames lists the names of the secrets in the deployment, limited to the given names
+func listSecretNames(t *testing.T, client service.SecretsClient, names []string) []string {
+	secrets, err := client.ListSecrets(context.Background())
+	assert.NoError(t, err)
+
+	result := []string{}
+	for _, secret := range secrets {
+		if utils.IndexOf(names, secret.SecretName) != -1 {
+			result = append(result, secret.SecretName)
+		}
+	}
+
+	return result
+}
+
+func secretInputFromSecret(secret schema.Secret) schema.SecretInput {
+	scopes := schema.SecretScopesInput{
+		FullDeploymentScope:       secret.FullDeploymentScope,
+		AllBranchDeploymentsScope: secret.AllBranchDeploymentsScope,
+		LocalDeploymentScope:      secret.LocalDeploymentScope,
+	}
+	if secret.SpecificBranchDeploymentScope != "" {
+		scopes.SpecificBranchDeploymentScope = &secret.SpecificBranchDeploymentScope
+	}
+
+	return schema.SecretInput{
+		Scopes:        scopes,
+		SecretName:    secret.SecretName,
+		SecretValue:   secret.SecretValue,
+		LocationNames: secret.LocationNames,
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+)
+
+type SSHKeysClient struct {
+	client graphql.Client
+}
+
+func NewSSHKeysClient(client graphql.Client) SSHKeysClient {
+	return SSHKeysClient{
+		client: client,
+	}
+}
+
+// ListSSHKeys retrieves all SSH keys of a user, including revoked keys
+func (c *SSHKeysClient) ListSSHKeys(ctx context.Context, userId int) ([]schema.SSHKey, error) {
+	resp, err := schema.ListSSHKeys(ctx, c.client, userId)
+	if err != nil {
+		return []schema.SSHKey{}, err
+	}
+
+	switch respCast := resp.SshKeysOrError.(type) {
+	case *schema.ListSSHKeysSshKeysOrErrorDagsterCloudSSHKeys:
+		keys := make([]schema.SSHKey, 0, len(respCast.Keys))
+		for _, key := range respCast.Keys {
+			keys = append(keys, key.SSHKey)
+		}
+
+		return keys, nil
+	case *schema.ListSSHKeysSshKeysOrErrorPythonError:
+		return []schema.SSHKey{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.ListSSHKeysSshKeysOrErrorUnauthorizedError:
+		return []schema.SSHKey{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return []schema.SSHKey{}, fmt.Errorf("unexpected type(%T) of result", resp.SshKeysOrError)
+	}
+}
+
+// GetSSHKeyById looks up an SSH key of a user by id and returns it, revoked keys are not found
+func (c *SSHKeysClient) GetSSHKeyById(ctx context.Context, userId int, id int) (schema.SSHKey, error) {
+	keys, err := c.ListSSHKeys(ctx, userId)
+	if err != nil {
+		return schema.SSHKey{}, err
+	}
+
+	for _, key := range keys {
+		if key.Id == id && !key.Revoked {
+			return key, nil
+		}
+	}
+
+	return schema.SSHKey{}, &types.ErrNotFound{What: "SSHKey", Key: "id", Value: strconv.Itoa(id)}
+}
+
+// CreateSSHKey registers a public SSH key for a user
+func (c *SSHKeysClient) CreateSSHKey(ctx context.Context, userId int, publicKey string, name string) (schema.SSHKey, error) {
+	resp, err := schema.CreateSSHKey(ctx, c.client, userId, publicKey, name)
+	if err != nil {
+		return schema.SSHKey{}, err
+	}
+
+	switch respCast := resp.CreateSSHKey.(type) {
+	case *schema.CreateSSHKeyCreateSSHKeyDagsterCloudSSHKey:
+		return respCast.SSHKey, nil
+	case *schema.CreateSSHKeyCreateSSHKeyDagsterCloudInvalidPublicKeyError:
+		return schema.SSHKey{}, &types.ErrInvalid{What: "SSHKey", Message: respCast.Message}
+	case *schema.CreateSSHKeyCreateSSHKeyPythonError:
+		return schema.SSHKey{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateSSHKeyCreateSSHKeyUnauthorizedError:
+		return schema.SSHKey{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.SSHKey{}, fmt.Errorf("unexpected type(%T) of result", resp.CreateSSHKey)
+	}
+}
+
+// RevokeSSHKey revokes an SSH key of a user by id
+func (c *SSHKeysClient) RevokeSSHKey(ctx context.Context, userId int, id int) error {
+	resp, err := schema.RevokeSSHKey(ctx, c.client, userId, id)
+	if err != nil {
+		return err
+	}
+
+	switch respCast := resp.RevokeSSHKey.(type) {
+	case *schema.RevokeSSHKeyRevokeSSHKeyDagsterCloudSSHKey:
+		return nil
+	case *schema.RevokeSSHKeyRevokeSSHKeyDagsterCloudSSHKeyNotFoundError:
+		return &types.ErrNotFound{What: "SSHKey", Key: "id", Value: strconv.Itoa(id)}
+	case *schema.RevokeSSHKeyRevokeSSHKeyPythonError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.RevokeSSHKeyRevokeSSHKeyUnauthorizedError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return fmt.Errorf("unexpected type(%T) of result", resp.RevokeSSHKey)
+	}
+}
@@ -0,0 +1,50 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSHKeysService_BasicCRUD(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars()
+	sshKeysClient := client.SSHKeysClient
+	ctx := context.Background()
+
+	var errNotFound *types.ErrNotFound
+	var errInvalid *types.ErrInvalid
+	userEmail := "test-user-ssh-key-dagster@test.com"
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEAyXxE+XUbrmGRSZDGzFoIQmwo+o9YAxMY7qClbMmvM terraform-provider-dagster-test"
+
+	user, err := client.UsersClient.AddUser(ctx, userEmail)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.UsersClient.RemoveUser(ctx, userEmail)
+	})
+
+	_, err = sshKeysClient.CreateSSHKey(ctx, user.UserId, "not-a-public-key", "invalid")
+	assert.ErrorAs(t, err, &errInvalid)
+
+	key, err := sshKeysClient.CreateSSHKey(ctx, user.UserId, publicKey, "testing ssh key")
+	assert.NoError(t, err)
+	assert.Equal(t, "testing ssh key", key.Name)
+
+	t.Cleanup(func() {
+		_ = sshKeysClient.RevokeSSHKey(ctx, user.UserId, key.Id)
+	})
+
+	keyById, err := sshKeysClient.GetSSHKeyById(ctx, user.UserId, key.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, key.PublicKey, keyById.PublicKey)
+
+	err = sshKeysClient.RevokeSSHKey(ctx, user.UserId, key.Id)
+	assert.NoError(t, err)
+
+	// Revoked keys are not found anymore
+	_, err = sshKeysClient.GetSSHKeyById(ctx, user.UserId, key.Id)
+	assert.ErrorAs(t, err, &errNotFound)
+}
@@ -166,6 +166,11 @@ func (c *TeamsClient) RenameTeam(ctx context.Context, name string, id string) (s
 }
 
 func (c *TeamsClient) GetTeamDeploymentGrantByTeamAndDeploymentId(ctx context.Context, teamId string, deploymentId int) (schema.ScopedPermissionGrant, error) {
+	return c.GetTeamGrant(ctx, teamId, schema.PermissionDeploymentScopeDeployment, deploymentId)
+}
+
+// GetTeamGrant retrieves the grant of a team in the given deployment scope, deploymentId is only used in the DEPLOYMENT scope
+func (c *TeamsClient) GetTeamGrant(ctx context.Context, teamId string, scope schema.PermissionDeploymentScope, deploymentId int) (schema.ScopedPermissionGrant, error) {
 	resp, err := schema.ListTeamPermissions(ctx, c.client)
 	if err != nil {
 		return schema.ScopedPermissionGrant{}, err
@@ -173,10 +178,8 @@ func (c *TeamsClient) GetTeamDeploymentGrantByTeamAndDeploymentId(ctx context.Co
 
 	for _, teamPermission := range resp.TeamPermissions {
 		if teamPermission.Id == teamId {
-			for _, grant := range teamPermission.DeploymentPermissionGrants {
-				if grant.DeploymentId == deploymentId {
-					return grant.ScopedPermissionGrant, nil
-				}
+			if grant, ok := teamGrantFromPermissions(teamPermission.TeamPermission, scope, deploymentId); ok {
+				return grant, nil
 			}
 		}
 	}
@@ -184,42 +187,42 @@ func (c *TeamsClient) GetTeamDeploymentGrantByTeamAndDeploymentId(ctx context.Co
 	return schema.ScopedPermissionGrant{}, &types.ErrNotFound{What: "DeploymentGrant", Key: "teamId", Value: teamId}
 }
 
-func (c *TeamsClient) CreateOrUpdateTeamDeploymentGrant(ctx context.Context, teamId string, deploymentId int, grant schema.PermissionGrant, locationGrants []schema.LocationScopedGrant) (schema.ScopedPermissionGrant, error) {
-	locationGrantsInput := make([]schema.LocationScopedGrantInput, 0, len(locationGrants))
-
-	deploymentGrantIdx := utils.IndexOf(types.DeploymentGrantEnumValues(), string(grant))
-
-	for _, locationGrant := range locationGrants {
-		locationGrantIdx := utils.IndexOf(types.DeploymentGrantEnumValues(), string(locationGrant.Grant))
-
-		if utils.IndexOf(types.LocationGrantEnumValues(), string(locationGrant.Grant)) == -1 {
-			return schema.ScopedPermissionGrant{}, &types.ErrInvalid{
-				What: "TeamDeploymentGrant",
-				Message: fmt.Sprintf(
-					"LocationGrant must be one of %v",
-					types.LocationGrantEnumValues(),
-				),
+// teamGrantFromPermissions picks the grant of the given deployment scope, grants that are not set have id 0
+func teamGrantFromPermissions(teamPermission schema.TeamPermission, scope schema.PermissionDeploymentScope, deploymentId int) (schema.ScopedPermissionGrant, bool) {
+	switch scope {
+	case schema.PermissionDeploymentScopeOrganization:
+		grant := teamPermission.OrganizationPermissionGrant.ScopedPermissionGrant
+		return grant, grant.Id != 0
+	case schema.PermissionDeploymentScopeAllBranchDeployments:
+		grant := teamPermission.AllBranchDeploymentsPermissionGrant.ScopedPermissionGrant
+		return grant, grant.Id != 0
+	default:
+		for _, grant := range teamPermission.DeploymentPermissionGrants {
+			if grant.DeploymentId == deploymentId {
+				return grant.ScopedPermissionGrant, true
 			}
 		}
 
-		if deploymentGrantIdx >= locationGrantIdx {
-			return schema.ScopedPermissionGrant{}, &types.ErrInvalid{
-				What:    "TeamDeploymentGrant",
-				Message: "LocationGrant can't be less permissive than DeploymentGrant",
-			}
-		}
+		return schema.ScopedPermissionGrant{}, false
+	}
+}
 
-		locationGrantsInput = append(
-			locationGrantsInput,
-			schema.LocationScopedGrantInput(locationGrant),
-		)
+func (c *TeamsClient) CreateOrUpdateTeamDeploymentGrant(ctx context.Context, teamId string, deploymentId int, grant schema.PermissionGrant, locationGrants []schema.LocationScopedGrant) (schema.ScopedPermissionGrant, error) {
+	return c.CreateOrUpdateTeamGrant(ctx, teamId, schema.PermissionDeploymentScopeDeployment, deploymentId, grant, locationGrants)
+}
+
+// CreateOrUpdateTeamGrant grants a team permissions in the given deployment scope, deploymentId is only used in the DEPLOYMENT scope
+func (c *TeamsClient) CreateOrUpdateTeamGrant(ctx context.Context, teamId string, scope schema.PermissionDeploymentScope, deploymentId int, grant schema.PermissionGrant, locationGrants []schema.LocationScopedGrant) (schema.ScopedPermissionGrant, error) {
+	locationGrantsInput, err := locationGrantsToInput("TeamDeploymentGrant", grant, locationGrants)
+	if err != nil {
+		return schema.ScopedPermissionGrant{}, err
 	}
 
 	resp, err := schema.CreateOrUpdateTeamPermission(
 		ctx,
 		c.client,
-		deploymentId,
-		schema.PermissionDeploymentScopeDeployment,
+		scopedGrantDeploymentId(scope, deploymentId),
+		scope,
 		grant,
 		locationGrantsInput,
 		teamId,
@@ -229,7 +232,7 @@ func (c *TeamsClient) CreateOrUpdateTeamDeploymentGrant(ctx context.Context, tea
 	}
 
 	// Get the Updated DeploymentGrant => Id changes every update
-	updatedPermissionGrant, err := c.GetTeamDeploymentGrantByTeamAndDeploymentId(ctx, teamId, deploymentId)
+	updatedPermissionGrant, err := c.GetTeamGrant(ctx, teamId, scope, deploymentId)
 	if err != nil {
 		return schema.ScopedPermissionGrant{}, err
 	}
@@ -249,11 +252,16 @@ func (c *TeamsClient) CreateOrUpdateTeamDeploymentGrant(ctx context.Context, tea
 }
 
 func (c *TeamsClient) RemoveTeamDeploymentGrant(ctx context.Context, teamId string, deploymentId int) error {
+	return c.RemoveTeamGrant(ctx, teamId, schema.PermissionDeploymentScopeDeployment, deploymentId)
+}
+
+// RemoveTeamGrant removes the grant of a team in the given deployment scope, deploymentId is only used in the DEPLOYMENT scope
+func (c *TeamsClient) RemoveTeamGrant(ctx context.Context, teamId string, scope schema.PermissionDeploymentScope, deploymentId int) error {
 	resp, err := schema.RemoveTeamPermission(
 		ctx,
 		c.client,
-		deploymentId,
-		schema.PermissionDeploymentScopeDeployment,
+		scopedGrantDeploymentId(scope, deploymentId),
+		scope,
 		teamId,
 	)
 	if err != nil {
@@ -330,3 +338,160 @@ func (c *TeamsClient) RemoveUserFromTeam(ctx context.Context, userId int, teamId
 		return fmt.Errorf("unexpected type(%T) of result", resp.RemoveMemberFromTeam)
 	}
 }
+
+// locationGrantsToInput validates that the location grants are at least as permissive as the deployment grant
+func locationGrantsToInput(what string, grant schema.PermissionGrant, locationGrants []schema.LocationScopedGrant) ([]schema.LocationScopedGrantInput, error) {
+	locationGrantsInput := make([]schema.LocationScopedGrantInput, 0, len(locationGrants))
+
+	deploymentGrantIdx := utils.IndexOf(types.DeploymentGrantEnumValues(), string(grant))
+
+	for _, locationGrant := range locationGrants {
+		locationGrantIdx := utils.IndexOf(types.DeploymentGrantEnumValues(), string(locationGrant.Grant))
+
+		if utils.IndexOf(types.LocationGrantEnumValues(), string(locationGrant.Grant)) == -1 {
+			return nil, &types.ErrInvalid{
+				What: what,
+				Message: fmt.Sprintf(
+					"LocationGrant must be one of %v",
+					types.LocationGrantEnumValues(),
+				),
+			}
+		}
+
+		if deploymentGrantIdx >= locationGrantIdx {
+			return nil, &types.ErrInvalid{
+				What:    what,
+				Message: "LocationGrant can't be less permissive than DeploymentGrant",
+			}
+		}
+
+		locationGrantsInput = append(
+			locationGrantsInput,
+			schema.LocationScopedGrantInput(locationGrant),
+		)
+	}
+
+	return locationGrantsInput, nil
+}
+
+// SetTeamMembers adds and removes members of a team so that its members are exactly the given users
+func (c *TeamsClient) SetTeamMembers(ctx context.Context, teamId string, userIds []int) error {
+	team, err := c.GetTeamById(ctx, teamId)
+	if err != nil {
+		return err
+	}
+
+	currentUserIds := make([]int, 0, len(team.Members))
+	for _, member := range team.Members {
+		currentUserIds = append(currentUserIds, member.UserId)
+	}
+
+	// Members are removed first, so a failure never leaves the team with more members than declared
+	for _, userId := range currentUserIds {
+		if utils.IndexOf(userIds, userId) != -1 {
+			continue
+		}
+
+		err = c.RemoveUserFromTeam(ctx, userId, teamId)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, userId := range userIds {
+		if utils.IndexOf(currentUserIds, userId) != -1 {
+			continue
+		}
+
+		err = c.AddUserToTeam(ctx, userId, teamId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListTeamDeploymentGrants retrieves the grants of a team on all deployments
+func (c *TeamsClient) ListTeamDeploymentGrants(ctx context.Context, teamId string) ([]schema.ScopedPermissionGrant, error) {
+	resp, err := schema.ListTeamPermissions(ctx, c.client)
+	if err != nil {
+		return []schema.ScopedPermissionGrant{}, err
+	}
+
+	for _, teamPermission := range resp.TeamPermissions {
+		if teamPermission.Id != teamId {
+			continue
+		}
+
+		grants := make([]schema.ScopedPermissionGrant, 0, len(teamPermission.DeploymentPermissionGrants))
+		for _, grant := range teamPermission.DeploymentPermissionGrants {
+			grants = append(grants, grant.ScopedPermissionGrant)
+		}
+
+		return grants, nil
+	}
+
+	return []schema.ScopedPermissionGrant{}, &types.ErrNotFound{What: "Team", Key: "id", Value: teamId}
+}
+
+// SetTeamDeploymentGrants removes, creates and updates grants of a team so that it only has the given grants, keyed by deployment id
+func (c *TeamsClient) SetTeamDeploymentGrants(ctx context.Context, teamId string, grants map[int]types.DeploymentGrant) error {
+	currentGrants, err := c.ListTeamDeploymentGrants(ctx, teamId)
+	if err != nil {
+		return err
+	}
+
+	currentGrantsByDeploymentId := make(map[int]schema.ScopedPermissionGrant, len(currentGrants))
+	for _, currentGrant := range currentGrants {
+		currentGrantsByDeploymentId[currentGrant.DeploymentId] = currentGrant
+	}
+
+	// Grants are removed first, so a failure never leaves the team with more access than declared
+	for deploymentId := range currentGrantsByDeploymentId {
+		if _, ok := grants[deploymentId]; ok {
+			continue
+		}
+
+		err = c.RemoveTeamDeploymentGrant(ctx, teamId, deploymentId)
+		if err != nil {
+			return err
+		}
+	}
+
+	for deploymentId, grant := range grants {
+		currentGrant, ok := currentGrantsByDeploymentId[deploymentId]
+		if ok && isSameDeploymentGrant(currentGrant, grant) {
+			continue
+		}
+
+		_, err = c.CreateOrUpdateTeamDeploymentGrant(ctx, teamId, deploymentId, grant.Grant, grant.LocationGrants)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isSameDeploymentGrant(currentGrant schema.ScopedPermissionGrant, grant types.DeploymentGrant) bool {
+	if currentGrant.Grant != grant.Grant || len(currentGrant.LocationGrants) != len(grant.LocationGrants) {
+		return false
+	}
+
+	for _, locationGrant := range grant.LocationGrants {
+		found := false
+		for _, currentLocationGrant := range currentGrant.LocationGrants {
+			if currentLocationGrant.LocationName == locationGrant.LocationName && currentLocationGrant.Grant == locationGrant.Grant {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -179,3 +179,102 @@ func TestTeamsDeploymentGrants(t *testing.T) {
 	_, err = teamsClient.GetTeamDeploymentGrantByTeamAndDeploymentId(ctx, team.Id, deployment.DeploymentId)
 	assert.ErrorAs(t, err, &errNotFound)
 }
+
+func TestTeamsScopedGrants(t *testing.T) {
+	teamsClient := testutils.GetDagsterClientFromEnvVars().TeamsClient
+	ctx := context.Background()
+
+	var errNotFound *types.ErrNotFound
+
+	team, err := teamsClient.CreateTeam(ctx, "test_team_scoped_grants")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = teamsClient.DeleteTeam(ctx, team.Id)
+	})
+
+	for _, scope := range []schema.PermissionDeploymentScope{
+		schema.PermissionDeploymentScopeOrganization,
+		schema.PermissionDeploymentScopeAllBranchDeployments,
+	} {
+		_, err = teamsClient.GetTeamGrant(ctx, team.Id, scope, 0)
+		assert.ErrorAs(t, err, &errNotFound)
+
+		grant, err := teamsClient.CreateOrUpdateTeamGrant(ctx, team.Id, scope, 0, schema.PermissionGrantLauncher, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, schema.PermissionGrantLauncher, grant.Grant)
+		assert.Equal(t, scope, grant.DeploymentScope)
+
+		grantRead, err := teamsClient.GetTeamGrant(ctx, team.Id, scope, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, grant.Id, grantRead.Id)
+
+		err = teamsClient.RemoveTeamGrant(ctx, team.Id, scope, 0)
+		assert.NoError(t, err)
+
+		_, err = teamsClient.GetTeamGrant(ctx, team.Id, scope, 0)
+		assert.ErrorAs(t, err, &errNotFound)
+	}
+}
+
+func TestTeamsSetTeamMembers(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars()
+	teamsClient := client.TeamsClient
+	ctx := context.Background()
+
+	user, err := client.UsersClient.AddUser(ctx, "test-user-team-members@test.com")
+	assert.NoError(t, err)
+
+	team, err := teamsClient.CreateTeam(ctx, "test_team_members")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = teamsClient.DeleteTeam(ctx, team.Id)
+		_ = client.UsersClient.RemoveUser(ctx, user.Email)
+	})
+
+	err = teamsClient.SetTeamMembers(ctx, team.Id, []int{user.UserId})
+	assert.NoError(t, err)
+
+	inTeam, err := teamsClient.IsUserInTeam(ctx, user.UserId, team.Id)
+	assert.NoError(t, err)
+	assert.True(t, inTeam)
+
+	err = teamsClient.SetTeamMembers(ctx, team.Id, []int{})
+	assert.NoError(t, err)
+
+	inTeam, err = teamsClient.IsUserInTeam(ctx, user.UserId, team.Id)
+	assert.NoError(t, err)
+	assert.False(t, inTeam)
+}
+
+func TestTeamsSetTeamDeploymentGrants(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars()
+	teamsClient := client.TeamsClient
+	ctx := context.Background()
+
+	deployment, err := client.DeploymentClient.GetCurrentDeployment(ctx)
+	assert.NoError(t, err)
+
+	team, err := teamsClient.CreateTeam(ctx, "test_team_deployment_grants")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = teamsClient.DeleteTeam(ctx, team.Id)
+	})
+
+	err = teamsClient.SetTeamDeploymentGrants(ctx, team.Id, map[int]types.DeploymentGrant{
+		deployment.DeploymentId: {Grant: schema.PermissionGrantViewer},
+	})
+	assert.NoError(t, err)
+
+	grants, err := teamsClient.ListTeamDeploymentGrants(ctx, team.Id)
+	assert.NoError(t, err)
+	assert.Len(t, grants, 1)
+	assert.Equal(t, deployment.DeploymentId, grants[0].DeploymentId)
+	assert.Equal(t, schema.PermissionGrantViewer, grants[0].Grant)
+
+	err = teamsClient.SetTeamDeploymentGrants(ctx, team.Id, map[int]types.DeploymentGrant{})
+	assert.NoError(t, err)
+
+	grants, err = teamsClient.ListTeamDeploymentGrants(ctx, team.Id)
+	assert.NoError(t, err)
+	assert.Empty(t, grants)
+}
@@ -0,0 +1,444 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+)
+
+type TokensClient struct {
+	client graphql.Client
+}
+
+func NewTokensClient(client graphql.Client) TokensClient {
+	return TokensClient{
+		client: client,
+	}
+}
+
+// ListAgentTokens retrieves all agent tokens of the organization, including revoked tokens
+func (c *TokensClient) ListAgentTokens(ctx context.Context) ([]schema.AgentToken, error) {
+	resp, err := schema.ListAgentTokens(ctx, c.client)
+	if err != nil {
+		return []schema.AgentToken{}, err
+	}
+
+	switch respCast := resp.AgentTokensOrError.(type) {
+	case *schema.ListAgentTokensAgentTokensOrErrorDagsterCloudAgentTokens:
+		tokens := make([]schema.AgentToken, 0, len(respCast.Tokens))
+		for _, token := range respCast.Tokens {
+			tokens = append(tokens, token.AgentToken)
+		}
+
+		return tokens, nil
+	case *schema.ListAgentTokensAgentTokensOrErrorPythonError:
+		return []schema.AgentToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.ListAgentTokensAgentTokensOrErrorUnauthorizedError:
+		return []schema.AgentToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return []schema.AgentToken{}, fmt.Errorf("unexpected type(%T) of result", resp.AgentTokensOrError)
+	}
+}
+
+// GetAgentTokenById looks up an agent token by id and returns it, revoked tokens are not found
+func (c *TokensClient) GetAgentTokenById(ctx context.Context, id int) (schema.AgentToken, error) {
+	tokens, err := c.ListAgentTokens(ctx)
+	if err != nil {
+		return schema.AgentToken{}, err
+	}
+
+	for _, token := range tokens {
+		if token.Id == id && !token.Revoked {
+			return token, nil
+		}
+	}
+
+	return schema.AgentToken{}, &types.ErrNotFound{What: "AgentToken", Key: "id", Value: strconv.Itoa(id)}
+}
+
+// CreateAgentToken creates an agent token with the given description
+func (c *TokensClient) CreateAgentToken(ctx context.Context, description string) (schema.AgentToken, error) {
+	resp, err := schema.CreateAgentToken(ctx, c.client, description)
+	if err != nil {
+		return schema.AgentToken{}, err
+	}
+
+	switch respCast := resp.CreateAgentToken.(type) {
+	case *schema.CreateAgentTokenCreateAgentTokenDagsterCloudAgentToken:
+		return respCast.AgentToken, nil
+	case *schema.CreateAgentTokenCreateAgentTokenPythonError:
+		return schema.AgentToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateAgentTokenCreateAgentTokenUnauthorizedError:
+		return schema.AgentToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.AgentToken{}, fmt.Errorf("unexpected type(%T) of result", resp.CreateAgentToken)
+	}
+}
+
+// EditAgentTokenDescription changes the description of an agent token
+func (c *TokensClient) EditAgentTokenDescription(ctx context.Context, id int, description string) (schema.AgentToken, error) {
+	resp, err := schema.EditAgentTokenDescription(ctx, c.client, id, description)
+	if err != nil {
+		return schema.AgentToken{}, err
+	}
+
+	switch respCast := resp.EditAgentTokenDescription.(type) {
+	case *schema.EditAgentTokenDescriptionEditAgentTokenDescriptionDagsterCloudAgentToken:
+		return respCast.AgentToken, nil
+	case *schema.EditAgentTokenDescriptionEditAgentTokenDescriptionDagsterCloudTokenNotFoundError:
+		return schema.AgentToken{}, &types.ErrNotFound{What: "AgentToken", Key: "id", Value: strconv.Itoa(id)}
+	case *schema.EditAgentTokenDescriptionEditAgentTokenDescriptionPythonError:
+		return schema.AgentToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.EditAgentTokenDescriptionEditAgentTokenDescriptionUnauthorizedError:
+		return schema.AgentToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.AgentToken{}, fmt.Errorf("unexpected type(%T) of result", resp.EditAgentTokenDescription)
+	}
+}
+
+// RevokeAgentToken revokes an agent token by id
+func (c *TokensClient) RevokeAgentToken(ctx context.Context, id int) error {
+	resp, err := schema.RevokeAgentToken(ctx, c.client, id)
+	if err != nil {
+		return err
+	}
+
+	switch respCast := resp.RevokeAgentToken.(type) {
+	case *schema.RevokeAgentTokenRevokeAgentTokenDagsterCloudAgentToken:
+		return nil
+	case *schema.RevokeAgentTokenRevokeAgentTokenDagsterCloudTokenNotFoundError:
+		return &types.ErrNotFound{What: "AgentToken", Key: "id", Value: strconv.Itoa(id)}
+	case *schema.RevokeAgentTokenRevokeAgentTokenPythonError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.RevokeAgentTokenRevokeAgentTokenUnauthorizedError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return fmt.Errorf("unexpected type(%T) of result", resp.RevokeAgentToken)
+	}
+}
+
+// GetAgentTokenGrant looks up the grant of an agent token in the given deployment scope, deploymentId is only used in the DEPLOYMENT scope
+func (c *TokensClient) GetAgentTokenGrant(ctx context.Context, tokenId int, scope schema.PermissionDeploymentScope, deploymentId int) (schema.ScopedPermissionGrant, error) {
+	resp, err := schema.ListAgentTokenPermissions(ctx, c.client)
+	if err != nil {
+		return schema.ScopedPermissionGrant{}, err
+	}
+
+	switch respCast := resp.AgentTokensOrError.(type) {
+	case *schema.ListAgentTokenPermissionsAgentTokensOrErrorDagsterCloudAgentTokens:
+		for _, token := range respCast.Tokens {
+			if token.Id != tokenId || token.Revoked {
+				continue
+			}
+
+			grant, ok := agentTokenGrantFromPermissions(token.AgentTokenPermissions, scope, deploymentId)
+			if ok {
+				return grant, nil
+			}
+		}
+
+		return schema.ScopedPermissionGrant{}, &types.ErrNotFound{What: "AgentTokenGrant", Key: "agentTokenId", Value: strconv.Itoa(tokenId)}
+	case *schema.ListAgentTokenPermissionsAgentTokensOrErrorPythonError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.ListAgentTokenPermissionsAgentTokensOrErrorUnauthorizedError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.ScopedPermissionGrant{}, fmt.Errorf("unexpected type(%T) of result", resp.AgentTokensOrError)
+	}
+}
+
+// CreateOrUpdateAgentTokenGrant grants an agent token permissions in the given deployment scope, deploymentId is only used in the DEPLOYMENT scope
+func (c *TokensClient) CreateOrUpdateAgentTokenGrant(ctx context.Context, tokenId int, scope schema.PermissionDeploymentScope, deploymentId int, grant schema.PermissionGrant) (schema.ScopedPermissionGrant, error) {
+	resp, err := schema.CreateOrUpdateAgentPermissions(ctx, c.client, schema.CreateOrUpdateCloudAgentPermissionsInput{
+		AgentTokenId:    tokenId,
+		DeploymentId:    scopedGrantDeploymentId(scope, deploymentId),
+		Grant:           grant,
+		DeploymentScope: scope,
+	})
+	if err != nil {
+		return schema.ScopedPermissionGrant{}, err
+	}
+
+	switch respCast := resp.CreateOrUpdateAgentPermissions.(type) {
+	case *schema.CreateOrUpdateAgentPermissionsCreateOrUpdateAgentPermissionsDagsterCloudAgentToken:
+		updatedGrant, ok := agentTokenGrantFromPermissions(respCast.AgentTokenPermissions, scope, deploymentId)
+		if !ok {
+			return schema.ScopedPermissionGrant{}, &types.ErrNotFound{What: "AgentTokenGrant", Key: "agentTokenId", Value: strconv.Itoa(tokenId)}
+		}
+
+		return updatedGrant, nil
+	case *schema.CreateOrUpdateAgentPermissionsCreateOrUpdateAgentPermissionsPythonError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateOrUpdateAgentPermissionsCreateOrUpdateAgentPermissionsUnauthorizedError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.ScopedPermissionGrant{}, fmt.Errorf("unexpected type(%T) of result", resp.CreateOrUpdateAgentPermissions)
+	}
+}
+
+// RemoveAgentTokenGrant removes the grant of an agent token in the given deployment scope, deploymentId is only used in the DEPLOYMENT scope.
+// Returns ErrNotFound when the token is revoked or has no grant in that scope.
+func (c *TokensClient) RemoveAgentTokenGrant(ctx context.Context, tokenId int, scope schema.PermissionDeploymentScope, deploymentId int) error {
+	// The API doesn't report missing grants, so they are looked up first
+	_, err := c.GetAgentTokenGrant(ctx, tokenId, scope, deploymentId)
+	if err != nil {
+		return err
+	}
+
+	resp, err := schema.RemoveAgentPermissions(ctx, c.client, schema.RemoveAgentPermissionsInput{
+		AgentTokenId:    tokenId,
+		DeploymentId:    scopedGrantDeploymentId(scope, deploymentId),
+		DeploymentScope: scope,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch respCast := resp.RemoveAgentPermissions.(type) {
+	case *schema.RemoveAgentPermissionsRemoveAgentPermissionsDagsterCloudAgentToken:
+		return nil
+	case *schema.RemoveAgentPermissionsRemoveAgentPermissionsPythonError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.RemoveAgentPermissionsRemoveAgentPermissionsUnauthorizedError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return fmt.Errorf("unexpected type(%T) of result", resp.RemoveAgentPermissions)
+	}
+}
+
+// scopedGrantDeploymentId only passes the deployment id for grants in the DEPLOYMENT scope
+func scopedGrantDeploymentId(scope schema.PermissionDeploymentScope, deploymentId int) *int {
+	if scope != schema.PermissionDeploymentScopeDeployment {
+		return nil
+	}
+
+	return &deploymentId
+}
+
+// agentTokenGrantFromPermissions picks the grant of the given deployment scope, grants that are not set have id 0
+func agentTokenGrantFromPermissions(tokenPermissions schema.AgentTokenPermissions, scope schema.PermissionDeploymentScope, deploymentId int) (schema.ScopedPermissionGrant, bool) {
+	permissions := tokenPermissions.Permissions
+
+	switch scope {
+	case schema.PermissionDeploymentScopeOrganization:
+		grant := permissions.OrganizationPermissionGrant.ScopedPermissionGrant
+		return grant, grant.Id != 0
+	case schema.PermissionDeploymentScopeAllBranchDeployments:
+		grant := permissions.AllBranchDeploymentsPermissionGrant.ScopedPermissionGrant
+		return grant, grant.Id != 0
+	default:
+		for _, grant := range permissions.DeploymentPermissionGrants {
+			if grant.DeploymentId == deploymentId {
+				return grant.ScopedPermissionGrant, true
+			}
+		}
+
+		return schema.ScopedPermissionGrant{}, false
+	}
+}
+
+// ListUserTokens retrieves all user tokens of a user, including revoked tokens
+func (c *TokensClient) ListUserTokens(ctx context.Context, userId int) ([]schema.UserToken, error) {
+	resp, err := schema.ListUserTokens(ctx, c.client, userId)
+	if err != nil {
+		return []schema.UserToken{}, err
+	}
+
+	switch respCast := resp.UserTokensOrError.(type) {
+	case *schema.ListUserTokensUserTokensOrErrorDagsterCloudUserTokens:
+		tokens := make([]schema.UserToken, 0, len(respCast.Tokens))
+		for _, token := range respCast.Tokens {
+			tokens = append(tokens, token.UserToken)
+		}
+
+		return tokens, nil
+	case *schema.ListUserTokensUserTokensOrErrorPythonError:
+		return []schema.UserToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.ListUserTokensUserTokensOrErrorUnauthorizedError:
+		return []schema.UserToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return []schema.UserToken{}, fmt.Errorf("unexpected type(%T) of result", resp.UserTokensOrError)
+	}
+}
+
+// GetUserTokenById looks up a user token of a user by id and returns it, revoked tokens are not found
+func (c *TokensClient) GetUserTokenById(ctx context.Context, userId int, id int) (schema.UserToken, error) {
+	tokens, err := c.ListUserTokens(ctx, userId)
+	if err != nil {
+		return schema.UserToken{}, err
+	}
+
+	for _, token := range tokens {
+		if token.Id == id && !token.Revoked {
+			return token, nil
+		}
+	}
+
+	return schema.UserToken{}, &types.ErrNotFound{What: "UserToken", Key: "id", Value: strconv.Itoa(id)}
+}
+
+// CreateUserToken creates a user token for a user with the given description
+func (c *TokensClient) CreateUserToken(ctx context.Context, userId int, description string) (schema.UserToken, error) {
+	resp, err := schema.CreateUserToken(ctx, c.client, userId, description)
+	if err != nil {
+		return schema.UserToken{}, err
+	}
+
+	switch respCast := resp.CreateUserToken.(type) {
+	case *schema.CreateUserTokenCreateUserTokenDagsterCloudUserToken:
+		return respCast.UserToken, nil
+	case *schema.CreateUserTokenCreateUserTokenPythonError:
+		return schema.UserToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateUserTokenCreateUserTokenUnauthorizedError:
+		return schema.UserToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.UserToken{}, fmt.Errorf("unexpected type(%T) of result", resp.CreateUserToken)
+	}
+}
+
+// EditUserTokenDescription changes the description of a user token
+func (c *TokensClient) EditUserTokenDescription(ctx context.Context, userId int, id int, description string) (schema.UserToken, error) {
+	resp, err := schema.EditUserTokenDescription(ctx, c.client, userId, id, description)
+	if err != nil {
+		return schema.UserToken{}, err
+	}
+
+	switch respCast := resp.EditUserTokenDescription.(type) {
+	case *schema.EditUserTokenDescriptionEditUserTokenDescriptionDagsterCloudUserToken:
+		return respCast.UserToken, nil
+	case *schema.EditUserTokenDescriptionEditUserTokenDescriptionDagsterCloudTokenNotFoundError:
+		return schema.UserToken{}, &types.ErrNotFound{What: "UserToken", Key: "id", Value: strconv.Itoa(id)}
+	case *schema.EditUserTokenDescriptionEditUserTokenDescriptionPythonError:
+		return schema.UserToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.EditUserTokenDescriptionEditUserTokenDescriptionUnauthorizedError:
+		return schema.UserToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.UserToken{}, fmt.Errorf("unexpected type(%T) of result", resp.EditUserTokenDescription)
+	}
+}
+
+// RevokeUserToken revokes a user token of a user by id
+func (c *TokensClient) RevokeUserToken(ctx context.Context, userId int, id int) error {
+	resp, err := schema.RevokeUserToken(ctx, c.client, userId, id)
+	if err != nil {
+		return err
+	}
+
+	switch respCast := resp.RevokeUserToken.(type) {
+	case *schema.RevokeUserTokenRevokeUserTokenDagsterCloudUserToken:
+		return nil
+	case *schema.RevokeUserTokenRevokeUserTokenDagsterCloudTokenNotFoundError:
+		return &types.ErrNotFound{What: "UserToken", Key: "id", Value: strconv.Itoa(id)}
+	case *schema.RevokeUserTokenRevokeUserTokenPythonError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.RevokeUserTokenRevokeUserTokenUnauthorizedError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return fmt.Errorf("unexpected type(%T) of result", resp.RevokeUserToken)
+	}
+}
+
+// ListApiTokens retrieves all API tokens of the given type, including revoked tokens
+func (c *TokensClient) ListApiTokens(ctx context.Context, tokenType schema.DagsterCloudApiTokenType) ([]schema.ApiToken, error) {
+	resp, err := schema.ListApiTokens(ctx, c.client, tokenType)
+	if err != nil {
+		return []schema.ApiToken{}, err
+	}
+
+	switch respCast := resp.ApiTokensOrError.(type) {
+	case *schema.ListApiTokensApiTokensOrErrorDagsterCloudApiTokens:
+		tokens := make([]schema.ApiToken, 0, len(respCast.Tokens))
+		for _, token := range respCast.Tokens {
+			tokens = append(tokens, token.ApiToken)
+		}
+
+		return tokens, nil
+	case *schema.ListApiTokensApiTokensOrErrorPythonError:
+		return []schema.ApiToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.ListApiTokensApiTokensOrErrorUnauthorizedError:
+		return []schema.ApiToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return []schema.ApiToken{}, fmt.Errorf("unexpected type(%T) of result", resp.ApiTokensOrError)
+	}
+}
+
+// GetApiTokenById looks up an API token of the given type by id and returns it, revoked tokens are not found
+func (c *TokensClient) GetApiTokenById(ctx context.Context, tokenType schema.DagsterCloudApiTokenType, id string) (schema.ApiToken, error) {
+	tokens, err := c.ListApiTokens(ctx, tokenType)
+	if err != nil {
+		return schema.ApiToken{}, err
+	}
+
+	for _, token := range tokens {
+		if token.Id == id && !token.Revoked {
+			return token, nil
+		}
+	}
+
+	return schema.ApiToken{}, &types.ErrNotFound{What: "ApiToken", Key: "id", Value: id}
+}
+
+// CreateApiToken creates an API token of the given type with the given description
+func (c *TokensClient) CreateApiToken(ctx context.Context, tokenType schema.DagsterCloudApiTokenType, description string) (schema.ApiToken, error) {
+	resp, err := schema.CreateApiToken(ctx, c.client, tokenType, description)
+	if err != nil {
+		return schema.ApiToken{}, err
+	}
+
+	switch respCast := resp.CreateApiToken.(type) {
+	case *schema.CreateApiTokenCreateApiTokenDagsterCloudApiToken:
+		return respCast.ApiToken, nil
+	case *schema.CreateApiTokenCreateApiTokenPythonError:
+		return schema.ApiToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateApiTokenCreateApiTokenUnauthorizedError:
+		return schema.ApiToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.ApiToken{}, fmt.Errorf("unexpected type(%T) of result", resp.CreateApiToken)
+	}
+}
+
+// EditApiTokenDescription changes the description of an API token
+func (c *TokensClient) EditApiTokenDescription(ctx context.Context, tokenType schema.DagsterCloudApiTokenType, id string, description string) (schema.ApiToken, error) {
+	resp, err := schema.EditApiTokenDescription(ctx, c.client, tokenType, id, description)
+	if err != nil {
+		return schema.ApiToken{}, err
+	}
+
+	switch respCast := resp.EditApiTokenDescription.(type) {
+	case *schema.EditApiTokenDescriptionEditApiTokenDescriptionDagsterCloudApiToken:
+		return respCast.ApiToken, nil
+	case *schema.EditApiTokenDescriptionEditApiTokenDescriptionDagsterCloudTokenNotFoundError:
+		return schema.ApiToken{}, &types.ErrNotFound{What: "ApiToken", Key: "id", Value: id}
+	case *schema.EditApiTokenDescriptionEditApiTokenDescriptionPythonError:
+		return schema.ApiToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.EditApiTokenDescriptionEditApiTokenDescriptionUnauthorizedError:
+		return schema.ApiToken{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.ApiToken{}, fmt.Errorf("unexpected type(%T) of result", resp.EditApiTokenDescription)
+	}
+}
+
+// RevokeApiToken revokes an API token of the given type by id
+func (c *TokensClient) RevokeApiToken(ctx context.Context, tokenType schema.DagsterCloudApiTokenType, id string) error {
+	resp, err := schema.RevokeApiToken(ctx, c.client, tokenType, id)
+	if err != nil {
+		return err
+	}
+
+	switch respCast := resp.RevokeApiToken.(type) {
+	case *schema.RevokeApiTokenRevokeApiTokenDagsterCloudApiToken:
+		return nil
+	case *schema.RevokeApiTokenRevokeApiTokenDagsterCloudTokenNotFoundError:
+		return &types.ErrNotFound{What: "ApiToken", Key: "id", Value: id}
+	case *schema.RevokeApiTokenRevokeApiTokenPythonError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.RevokeApiTokenRevokeApiTokenUnauthorizedError:
+		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return fmt.Errorf("unexpected type(%T) of result", resp.RevokeApiToken)
+	}
+}
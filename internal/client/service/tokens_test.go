@@ -0,0 +1,161 @@
+package service_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokensService_AgentTokenBasicCRUD(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars().TokensClient
+	ctx := context.Background()
+
+	var errNotFound *types.ErrNotFound
+
+	token, err := client.CreateAgentToken(ctx, "testing agent token")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token.Token)
+	assert.Equal(t, "testing agent token", token.Description)
+
+	t.Cleanup(func() {
+		_ = client.RevokeAgentToken(ctx, token.Id)
+	})
+
+	tokenById, err := client.GetAgentTokenById(ctx, token.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, token.Token, tokenById.Token)
+
+	tokenUpdated, err := client.EditAgentTokenDescription(ctx, token.Id, "testing agent token updated")
+	assert.NoError(t, err)
+	assert.Equal(t, "testing agent token updated", tokenUpdated.Description)
+
+	err = client.RevokeAgentToken(ctx, token.Id)
+	assert.NoError(t, err)
+
+	// Revoked tokens are not found anymore
+	_, err = client.GetAgentTokenById(ctx, token.Id)
+	assert.ErrorAs(t, err, &errNotFound)
+}
+
+func TestTokensService_AgentTokenGrants(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars()
+	tokensClient := client.TokensClient
+	ctx := context.Background()
+
+	var errNotFound *types.ErrNotFound
+
+	deploymentName := os.Getenv("TF_VAR_testing_dagster_deployment")
+	deployment, err := client.DeploymentClient.GetDeploymentByName(ctx, deploymentName)
+	if err != nil {
+		t.Fatalf("Error getting deployment with name %s", deploymentName)
+	}
+
+	token, err := tokensClient.CreateAgentToken(ctx, "testing agent token grants")
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = tokensClient.RevokeAgentToken(ctx, token.Id)
+	})
+
+	// Grant on a single deployment
+	grant, err := tokensClient.CreateOrUpdateAgentTokenGrant(ctx, token.Id, schema.PermissionDeploymentScopeDeployment, deployment.DeploymentId, schema.PermissionGrantAgent)
+	assert.NoError(t, err)
+	assert.Equal(t, deployment.DeploymentId, grant.DeploymentId)
+	assert.Equal(t, schema.PermissionGrantAgent, grant.Grant)
+
+	grantRead, err := tokensClient.GetAgentTokenGrant(ctx, token.Id, schema.PermissionDeploymentScopeDeployment, deployment.DeploymentId)
+	assert.NoError(t, err)
+	assert.Equal(t, grant.Id, grantRead.Id)
+
+	err = tokensClient.RemoveAgentTokenGrant(ctx, token.Id, schema.PermissionDeploymentScopeDeployment, deployment.DeploymentId)
+	assert.NoError(t, err)
+
+	_, err = tokensClient.GetAgentTokenGrant(ctx, token.Id, schema.PermissionDeploymentScopeDeployment, deployment.DeploymentId)
+	assert.ErrorAs(t, err, &errNotFound)
+
+	err = tokensClient.RemoveAgentTokenGrant(ctx, token.Id, schema.PermissionDeploymentScopeDeployment, deployment.DeploymentId)
+	assert.ErrorAs(t, err, &errNotFound)
+
+	// Grant on all branch deployments, the deployment id is ignored
+	grant, err = tokensClient.CreateOrUpdateAgentTokenGrant(ctx, token.Id, schema.PermissionDeploymentScopeAllBranchDeployments, 0, schema.PermissionGrantAgent)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.PermissionDeploymentScopeAllBranchDeployments, grant.DeploymentScope)
+
+	err = tokensClient.RemoveAgentTokenGrant(ctx, token.Id, schema.PermissionDeploymentScopeAllBranchDeployments, 0)
+	assert.NoError(t, err)
+
+	_, err = tokensClient.GetAgentTokenGrant(ctx, token.Id, schema.PermissionDeploymentScopeAllBranchDeployments, 0)
+	assert.ErrorAs(t, err, &errNotFound)
+}
+
+func TestTokensService_UserTokenBasicCRUD(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars()
+	tokensClient := client.TokensClient
+	ctx := context.Background()
+
+	var errNotFound *types.ErrNotFound
+	userEmail := "test-user-token-dagster@test.com"
+
+	user, err := client.UsersClient.AddUser(ctx, userEmail)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.UsersClient.RemoveUser(ctx, userEmail)
+	})
+
+	token, err := tokensClient.CreateUserToken(ctx, user.UserId, "testing user token")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token.Token)
+	assert.Equal(t, "testing user token", token.Description)
+
+	tokenById, err := tokensClient.GetUserTokenById(ctx, user.UserId, token.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, token.Token, tokenById.Token)
+
+	tokenUpdated, err := tokensClient.EditUserTokenDescription(ctx, user.UserId, token.Id, "testing user token updated")
+	assert.NoError(t, err)
+	assert.Equal(t, "testing user token updated", tokenUpdated.Description)
+
+	err = tokensClient.RevokeUserToken(ctx, user.UserId, token.Id)
+	assert.NoError(t, err)
+
+	// Revoked tokens are not found anymore
+	_, err = tokensClient.GetUserTokenById(ctx, user.UserId, token.Id)
+	assert.ErrorAs(t, err, &errNotFound)
+}
+
+func TestTokensService_ApiTokenBasicCRUD(t *testing.T) {
+	client := testutils.GetDagsterClientFromEnvVars().TokensClient
+	ctx := context.Background()
+
+	var errNotFound *types.ErrNotFound
+
+	token, err := client.CreateApiToken(ctx, schema.DagsterCloudApiTokenTypeScim, "testing scim token")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token.Token)
+	assert.Equal(t, schema.DagsterCloudApiTokenTypeScim, token.TokenType)
+
+	t.Cleanup(func() {
+		_ = client.RevokeApiToken(ctx, schema.DagsterCloudApiTokenTypeScim, token.Id)
+	})
+
+	tokenById, err := client.GetApiTokenById(ctx, schema.DagsterCloudApiTokenTypeScim, token.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, token.Token, tokenById.Token)
+
+	tokenUpdated, err := client.EditApiTokenDescription(ctx, schema.DagsterCloudApiTokenTypeScim, token.Id, "testing scim token updated")
+	assert.NoError(t, err)
+	assert.Equal(t, "testing scim token updated", tokenUpdated.Description)
+
+	err = client.RevokeApiToken(ctx, schema.DagsterCloudApiTokenTypeScim, token.Id)
+	assert.NoError(t, err)
+
+	// Revoked tokens are not found anymore
+	_, err = client.GetApiTokenById(ctx, schema.DagsterCloudApiTokenTypeScim, token.Id)
+	assert.ErrorAs(t, err, &errNotFound)
+}
@@ -157,8 +157,82 @@ func (c UsersClient) RemoveUserPermission(ctx context.Context, email string, dep
 	case *schema.RemoveUserPermissionRemoveUserPermissionsUserLimitError:
 		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
 	case *schema.RemoveUserPermissionRemoveUserPermissionsUserNotFoundError:
-		return &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+		return &types.ErrNotFound{What: "User", Key: "email", Value: email}
 	default:
 		return fmt.Errorf("unexpected type(%T) of result", resp.RemoveUserPermissions)
 	}
 }
+
+// GetUserDeploymentGrantByEmailAndDeploymentId retrieves the grant of a user on a deployment
+func (c UsersClient) GetUserDeploymentGrantByEmailAndDeploymentId(ctx context.Context, email string, deploymentId int) (schema.ScopedPermissionGrant, error) {
+	result, err := schema.GetUsers(ctx, c.client)
+	if err != nil {
+		return schema.ScopedPermissionGrant{}, err
+	}
+
+	switch respCast := result.UsersOrError.(type) {
+	case *schema.GetUsersUsersOrErrorDagsterCloudUsersWithScopedPermissionGrants:
+		for _, user := range respCast.Users {
+			if user.User.Email != email {
+				continue
+			}
+
+			for _, grant := range user.DeploymentPermissionGrants {
+				if grant.DeploymentId == deploymentId {
+					return grant.ScopedPermissionGrant, nil
+				}
+			}
+		}
+
+		return schema.ScopedPermissionGrant{}, &types.ErrNotFound{What: "UserDeploymentGrant", Key: "email", Value: email}
+	case *schema.GetUsersUsersOrErrorPythonError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.GetUsersUsersOrErrorUnauthorizedError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.ScopedPermissionGrant{}, fmt.Errorf("unexpected type(%T) of result", result.UsersOrError)
+	}
+}
+
+// CreateOrUpdateUserDeploymentGrant grants a user permissions on a deployment and its code locations
+func (c UsersClient) CreateOrUpdateUserDeploymentGrant(ctx context.Context, email string, deploymentId int, grant schema.PermissionGrant, locationGrants []schema.LocationScopedGrant) (schema.ScopedPermissionGrant, error) {
+	locationGrantsInput, err := locationGrantsToInput("UserDeploymentGrant", grant, locationGrants)
+	if err != nil {
+		return schema.ScopedPermissionGrant{}, err
+	}
+
+	resp, err := schema.CreateOrUpdateUserPermission(
+		ctx,
+		c.client,
+		email,
+		deploymentId,
+		schema.PermissionDeploymentScopeDeployment,
+		grant,
+		locationGrantsInput,
+	)
+	if err != nil {
+		return schema.ScopedPermissionGrant{}, err
+	}
+
+	switch respCast := resp.CreateOrUpdateUserPermissions.(type) {
+	case *schema.CreateOrUpdateUserPermissionCreateOrUpdateUserPermissionsDagsterCloudUserWithScopedPermissionGrants:
+		for _, deploymentGrant := range respCast.DeploymentPermissionGrants {
+			if deploymentGrant.DeploymentId == deploymentId {
+				return deploymentGrant.ScopedPermissionGrant, nil
+			}
+		}
+		return schema.ScopedPermissionGrant{}, &types.ErrNotFound{What: "UserDeploymentGrant", Key: "email", Value: email}
+	case *schema.CreateOrUpdateUserPermissionCreateOrUpdateUserPermissionsCantRemoveAllAdminsError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateOrUpdateUserPermissionCreateOrUpdateUserPermissionsPythonError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateOrUpdateUserPermissionCreateOrUpdateUserPermissionsUnauthorizedError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateOrUpdateUserPermissionCreateOrUpdateUserPermissionsUserLimitError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	case *schema.CreateOrUpdateUserPermissionCreateOrUpdateUserPermissionsUserNotFoundError:
+		return schema.ScopedPermissionGrant{}, &types.ErrApi{Typename: respCast.Typename, Message: respCast.Message}
+	default:
+		return schema.ScopedPermissionGrant{}, fmt.Errorf("unexpected type(%T) of result", resp.CreateOrUpdateUserPermissions)
+	}
+}
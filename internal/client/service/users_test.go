@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
 	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
 	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
 	"github.com/stretchr/testify/assert"
@@ -106,3 +107,47 @@ func TestUserService_Teams(t *testing.T) {
 	err = userClient.RemoveUser(ctx, createdUser.Email)
 	assert.NoError(t, err)
 }
+
+func TestUserService_DeploymentGrant(t *testing.T) {
+	dagsterClient := testutils.GetDagsterClientFromEnvVars()
+	client := dagsterClient.UsersClient
+	ctx := context.Background()
+
+	var errNotFound *types.ErrNotFound
+	var errInvalid *types.ErrInvalid
+	userEmail := "test-user-grant-dagster@test.com"
+
+	deployment, err := dagsterClient.DeploymentClient.GetCurrentDeployment(ctx)
+	assert.NoError(t, err)
+
+	_, err = client.AddUser(ctx, userEmail)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.RemoveUser(ctx, userEmail)
+	})
+
+	// Location grants can't be less permissive than the deployment grant
+	_, err = client.CreateOrUpdateUserDeploymentGrant(ctx, userEmail, deployment.DeploymentId, schema.PermissionGrantEditor, []schema.LocationScopedGrant{
+		{LocationName: "some-location", Grant: schema.PermissionGrantLauncher},
+	})
+	assert.ErrorAs(t, err, &errInvalid)
+
+	grant, err := client.CreateOrUpdateUserDeploymentGrant(ctx, userEmail, deployment.DeploymentId, schema.PermissionGrantViewer, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.PermissionGrantViewer, grant.Grant)
+
+	grant, err = client.CreateOrUpdateUserDeploymentGrant(ctx, userEmail, deployment.DeploymentId, schema.PermissionGrantLauncher, nil)
+	assert.NoError(t, err)
+
+	readGrant, err := client.GetUserDeploymentGrantByEmailAndDeploymentId(ctx, userEmail, deployment.DeploymentId)
+	assert.NoError(t, err)
+	assert.Equal(t, grant.Id, readGrant.Id)
+	assert.Equal(t, schema.PermissionGrantLauncher, readGrant.Grant)
+
+	err = client.RemoveUserPermission(ctx, userEmail, deployment.DeploymentId, schema.PermissionDeploymentScopeDeployment)
+	assert.NoError(t, err)
+
+	_, err = client.GetUserDeploymentGrantByEmailAndDeploymentId(ctx, userEmail, deployment.DeploymentId)
+	assert.ErrorAs(t, err, &errNotFound)
+}
@@ -0,0 +1,202 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+)
+
+// ConfigSchema is a Dagster config schema, like the schema of the deployment settings
+type ConfigSchema struct {
+	RootKey string
+	Types   map[string]ConfigType
+}
+
+// ConfigType is a type in a Dagster config schema, Kind is the GraphQL type name, like CompositeConfigType
+type ConfigType struct {
+	Kind             string
+	Key              string
+	GivenName        string
+	IsSelector       bool
+	TypeParamKeys    []string
+	Fields           []ConfigField
+	EnumValues       []string
+	ScalarTypeKey    string
+	NonScalarTypeKey string
+}
+
+type ConfigField struct {
+	Name       string
+	TypeKey    string
+	IsRequired bool
+}
+
+// ConfigError points to the key in a document that doesn't match the config schema
+type ConfigError struct {
+	Path    string
+	Message string
+}
+
+func (e ConfigError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Validate checks a document against the config schema, partial documents leave out required fields
+func (s ConfigSchema) Validate(document interface{}, partial bool) []ConfigError {
+	if s.RootKey == "" {
+		return nil
+	}
+
+	return s.validate(s.RootKey, document, "", partial)
+}
+
+func (s ConfigSchema) validate(typeKey string, value interface{}, path string, partial bool) []ConfigError {
+	configType, ok := s.Types[typeKey]
+	if !ok {
+		// Types that are not part of the schema are not checked
+		return nil
+	}
+
+	switch configType.Kind {
+	case "CompositeConfigType":
+		return s.validateComposite(configType, value, path, partial)
+	case "ArrayConfigType":
+		array, ok := value.([]interface{})
+		if !ok {
+			return []ConfigError{{Path: path, Message: "expected a list"}}
+		}
+
+		var errs []ConfigError
+		for i, element := range array {
+			errs = append(errs, s.validate(typeParamKey(configType, 0), element, fmt.Sprintf("%s[%d]", path, i), partial)...)
+		}
+		return errs
+	case "MapConfigType":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return []ConfigError{{Path: path, Message: "expected a map"}}
+		}
+
+		var errs []ConfigError
+		for _, key := range sortedKeys(object) {
+			errs = append(errs, s.validate(typeParamKey(configType, 1), object[key], joinPath(path, key), partial)...)
+		}
+		return errs
+	case "NullableConfigType":
+		if value == nil {
+			return nil
+		}
+		return s.validate(typeParamKey(configType, 0), value, path, partial)
+	case "EnumConfigType":
+		str, ok := value.(string)
+		if !ok || utils.IndexOf(configType.EnumValues, str) == -1 {
+			return []ConfigError{{Path: path, Message: fmt.Sprintf("expected one of %s", strings.Join(configType.EnumValues, ", "))}}
+		}
+		return nil
+	case "ScalarUnionConfigType":
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return s.validate(configType.NonScalarTypeKey, value, path, partial)
+		default:
+			return s.validate(configType.ScalarTypeKey, value, path, partial)
+		}
+	case "RegularConfigType":
+		return validateScalar(configType.GivenName, value, path)
+	default:
+		return nil
+	}
+}
+
+func (s ConfigSchema) validateComposite(configType ConfigType, value interface{}, path string, partial bool) []ConfigError {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return []ConfigError{{Path: path, Message: "expected a map"}}
+	}
+
+	fields := make(map[string]ConfigField, len(configType.Fields))
+	fieldNames := make([]string, 0, len(configType.Fields))
+	for _, field := range configType.Fields {
+		fields[field.Name] = field
+		fieldNames = append(fieldNames, field.Name)
+	}
+	sort.Strings(fieldNames)
+
+	var errs []ConfigError
+	for _, key := range sortedKeys(object) {
+		field, ok := fields[key]
+		if !ok {
+			errs = append(errs, ConfigError{Path: joinPath(path, key), Message: fmt.Sprintf("unexpected key, expected one of %s", strings.Join(fieldNames, ", "))})
+			continue
+		}
+		errs = append(errs, s.validate(field.TypeKey, object[key], joinPath(path, key), partial)...)
+	}
+
+	if configType.IsSelector {
+		if len(object) != 1 {
+			errs = append(errs, ConfigError{Path: path, Message: fmt.Sprintf("expected exactly one of %s", strings.Join(fieldNames, ", "))})
+		}
+		return errs
+	}
+
+	if !partial {
+		for _, name := range fieldNames {
+			if _, ok := object[name]; !ok && fields[name].IsRequired {
+				errs = append(errs, ConfigError{Path: joinPath(path, name), Message: "missing required key"})
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateScalar(name string, value interface{}, path string) []ConfigError {
+	var ok bool
+	switch name {
+	case "Int":
+		number, isNumber := value.(float64)
+		ok = isNumber && number == math.Trunc(number)
+	case "Float":
+		_, ok = value.(float64)
+	case "String":
+		_, ok = value.(string)
+	case "Bool":
+		_, ok = value.(bool)
+	default:
+		// Any and other scalars accept every value
+		ok = true
+	}
+
+	if !ok {
+		return []ConfigError{{Path: path, Message: fmt.Sprintf("expected a value of type %s", name)}}
+	}
+	return nil
+}
+
+func typeParamKey(configType ConfigType, index int) string {
+	if index >= len(configType.TypeParamKeys) {
+		return ""
+	}
+	return configType.TypeParamKeys[index]
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
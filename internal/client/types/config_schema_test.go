@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testConfigSchema = types.ConfigSchema{
+	RootKey: "Settings",
+	Types: map[string]types.ConfigType{
+		"Settings": {
+			Kind: "CompositeConfigType",
+			Key:  "Settings",
+			Fields: []types.ConfigField{
+				{Name: "run_queue", TypeKey: "RunQueue"},
+				{Name: "sso_default_role", TypeKey: "Role"},
+				{Name: "name", TypeKey: "String", IsRequired: true},
+			},
+		},
+		"RunQueue": {
+			Kind: "CompositeConfigType",
+			Key:  "RunQueue",
+			Fields: []types.ConfigField{
+				{Name: "max_concurrent_runs", TypeKey: "Int"},
+				{Name: "tag_concurrency_limits", TypeKey: "Array.Limit"},
+			},
+		},
+		"Array.Limit": {Kind: "ArrayConfigType", Key: "Array.Limit", TypeParamKeys: []string{"Limit"}},
+		"Limit": {
+			Kind: "CompositeConfigType",
+			Key:  "Limit",
+			Fields: []types.ConfigField{
+				{Name: "key", TypeKey: "String", IsRequired: true},
+				{Name: "limit", TypeKey: "Int", IsRequired: true},
+			},
+		},
+		"Role":   {Kind: "EnumConfigType", Key: "Role", EnumValues: []string{"VIEWER", "EDITOR"}},
+		"Int":    {Kind: "RegularConfigType", Key: "Int", GivenName: "Int"},
+		"String": {Kind: "RegularConfigType", Key: "String", GivenName: "String"},
+	},
+}
+
+func validateTestDocument(document string, partial bool) []types.ConfigError {
+	var value interface{}
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		panic(err)
+	}
+
+	return testConfigSchema.Validate(value, partial)
+}
+
+func TestConfigSchemaValidate(t *testing.T) {
+	errs := validateTestDocument(`{"name": "a", "run_queue": {"max_concurrent_runs": 10, "tag_concurrency_limits": [{"key": "a", "limit": 1}]}, "sso_default_role": "VIEWER"}`, false)
+	assert.Empty(t, errs)
+
+	errs = validateTestDocument(`{"name": "a", "run_queue": {"max_concurent_runs": 10}}`, false)
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, "run_queue.max_concurent_runs", errs[0].Path)
+	}
+
+	errs = validateTestDocument(`{"name": "a", "run_queue": {"max_concurrent_runs": 1.5, "tag_concurrency_limits": [{"key": "a", "limit": "1"}]}}`, false)
+	if assert.Len(t, errs, 2) {
+		assert.Equal(t, "run_queue.max_concurrent_runs", errs[0].Path)
+		assert.Equal(t, "run_queue.tag_concurrency_limits[0].limit", errs[1].Path)
+	}
+
+	errs = validateTestDocument(`{"name": "a", "sso_default_role": "OWNER"}`, false)
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, "sso_default_role", errs[0].Path)
+	}
+
+	// Required keys are only checked for complete documents
+	errs = validateTestDocument(`{"run_queue": {}}`, false)
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, "name", errs[0].Path)
+	}
+	assert.Empty(t, validateTestDocument(`{"run_queue": {}}`, true))
+
+	// Without a schema nothing is checked
+	assert.Empty(t, types.ConfigSchema{}.Validate(map[string]interface{}{"a": 1}, false))
+}
@@ -1,5 +1,7 @@
 package types
 
+import "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+
 type CodeLocationsAsDocumentResponse struct {
 	Locations []CodeLocation `json:"locations"`
 }
@@ -25,3 +27,61 @@ type CodeLocationGit struct {
 	CommitHash string `json:"commit_hash"`
 	URL        string `json:"url"`
 }
+
+type AlertPoliciesAsDocumentResponse struct {
+	AlertPolicies []AlertPolicy `json:"alert_policies"`
+}
+
+type AlertPolicy struct {
+	Name                string                         `json:"name"`
+	Description         string                         `json:"description"`
+	Tags                []AlertPolicyTag               `json:"tags,omitempty"`
+	EventTypes          []string                       `json:"event_types"`
+	NotificationService AlertPolicyNotificationService `json:"notification_service"`
+	Enabled             *bool                          `json:"enabled,omitempty"`
+	AlertTargets        []AlertPolicyTarget            `json:"alert_targets,omitempty"`
+}
+
+type AlertPolicyTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+type AlertPolicyNotificationService struct {
+	Email     *AlertPolicyEmailNotification     `json:"email,omitempty"`
+	Slack     *AlertPolicySlackNotification     `json:"slack,omitempty"`
+	PagerDuty *AlertPolicyPagerDutyNotification `json:"pagerduty,omitempty"`
+}
+
+type AlertPolicyEmailNotification struct {
+	EmailAddresses []string `json:"email_addresses"`
+}
+
+type AlertPolicySlackNotification struct {
+	SlackWorkspaceName string `json:"slack_workspace_name"`
+	SlackChannelName   string `json:"slack_channel_name"`
+}
+
+type AlertPolicyPagerDutyNotification struct {
+	IntegrationKey string `json:"integration_key"`
+}
+
+type AlertPolicyTarget struct {
+	AssetGroupTarget *AlertPolicyAssetGroupTarget `json:"asset_group_target,omitempty"`
+	AssetKeyTarget   *AlertPolicyAssetKeyTarget   `json:"asset_key_target,omitempty"`
+}
+
+type AlertPolicyAssetGroupTarget struct {
+	AssetGroup   string `json:"asset_group"`
+	LocationName string `json:"location_name"`
+	RepoName     string `json:"repo_name,omitempty"`
+}
+
+type AlertPolicyAssetKeyTarget struct {
+	AssetKey []string `json:"asset_key"`
+}
+
+type DeploymentGrant struct {
+	Grant          schema.PermissionGrant
+	LocationGrants []schema.LocationScopedGrant
+}
@@ -12,6 +12,7 @@ var grantMap = map[string]schema.PermissionGrant{
 	"LAUNCHER": schema.PermissionGrantLauncher,
 	"EDITOR":   schema.PermissionGrantEditor,
 	"ADMIN":    schema.PermissionGrantAdmin,
+	"AGENT":    schema.PermissionGrantAgent,
 }
 
 func ConvertToGrantEnum(grant string) (schema.PermissionGrant, error) {
@@ -27,6 +28,108 @@ func DeploymentGrantEnumValues() []string {
 	return []string{"VIEWER", "LAUNCHER", "EDITOR", "ADMIN"}
 }
 
+func AgentGrantEnumValues() []string {
+	return []string{"VIEWER", "LAUNCHER", "EDITOR", "ADMIN", "AGENT"}
+}
+
 func LocationGrantEnumValues() []string {
 	return []string{"LAUNCHER", "EDITOR", "ADMIN"}
 }
+
+func AlertPolicyEventTypeEnumValues() []string {
+	return []string{
+		"JOB_FAILURE",
+		"JOB_SUCCESS",
+		"TICK_FAILURE",
+		"AGENT_UNAVAILABLE",
+		"CODE_LOCATION_ERROR",
+		"ASSET_MATERIALIZATION_SUCCESS",
+		"ASSET_MATERIALIZATION_FAILURE",
+		"ASSET_CHECK_PASSED",
+		"ASSET_CHECK_EXECUTION_FAILURE",
+		"ASSET_CHECK_SEVERITY_WARN",
+		"ASSET_CHECK_SEVERITY_ERROR",
+		"ASSET_OVERDUE",
+	}
+}
+
+var deploymentScopeMap = map[string]schema.PermissionDeploymentScope{
+	"DEPLOYMENT":             schema.PermissionDeploymentScopeDeployment,
+	"ORGANIZATION":           schema.PermissionDeploymentScopeOrganization,
+	"ALL_BRANCH_DEPLOYMENTS": schema.PermissionDeploymentScopeAllBranchDeployments,
+}
+
+func ConvertToDeploymentScopeEnum(scope string) (schema.PermissionDeploymentScope, error) {
+	enum, ok := deploymentScopeMap[strings.ToUpper(scope)]
+	if !ok {
+		return "", fmt.Errorf("could not convert (%s) to deployment scope enum", scope)
+	}
+
+	return enum, nil
+}
+
+func DeploymentScopeEnumValues() []string {
+	return []string{"DEPLOYMENT", "ORGANIZATION", "ALL_BRANCH_DEPLOYMENTS"}
+}
+
+var apiTokenTypeMap = map[string]schema.DagsterCloudApiTokenType{
+	"SCIM": schema.DagsterCloudApiTokenTypeScim,
+}
+
+func ConvertToApiTokenTypeEnum(tokenType string) (schema.DagsterCloudApiTokenType, error) {
+	enum, ok := apiTokenTypeMap[strings.ToUpper(tokenType)]
+	if !ok {
+		return "", fmt.Errorf("could not convert (%s) to api token type enum", tokenType)
+	}
+
+	return enum, nil
+}
+
+func ApiTokenTypeEnumValues() []string {
+	return []string{"SCIM"}
+}
+
+var deploymentAgentTypeMap = map[string]schema.DeploymentAgentType{
+	"HYBRID":     schema.DeploymentAgentTypeHybrid,
+	"SERVERLESS": schema.DeploymentAgentTypeServerless,
+}
+
+func ConvertToDeploymentAgentTypeEnum(agentType string) (schema.DeploymentAgentType, error) {
+	enum, ok := deploymentAgentTypeMap[strings.ToUpper(agentType)]
+	if !ok {
+		return "", fmt.Errorf("could not convert (%s) to deployment agent type enum", agentType)
+	}
+
+	return enum, nil
+}
+
+func DeploymentAgentTypeEnumValues() []string {
+	return []string{"HYBRID", "SERVERLESS"}
+}
+
+var pullRequestStatusMap = map[string]schema.PullRequestStatus{
+	"OPEN":   schema.PullRequestStatusOpen,
+	"CLOSED": schema.PullRequestStatusClosed,
+	"MERGED": schema.PullRequestStatusMerged,
+}
+
+func ConvertToPullRequestStatusEnum(status string) (schema.PullRequestStatus, error) {
+	enum, ok := pullRequestStatusMap[strings.ToUpper(status)]
+	if !ok {
+		return "", fmt.Errorf("could not convert (%s) to pull request status enum", status)
+	}
+
+	return enum, nil
+}
+
+func PullRequestStatusEnumValues() []string {
+	return []string{"OPEN", "CLOSED", "MERGED"}
+}
+
+func DeploymentTypeEnumValues() []string {
+	return []string{"PRODUCTION", "DEV", "BRANCH"}
+}
+
+func DeploymentStatusEnumValues() []string {
+	return []string{"ACTIVE", "PENDING_DELETION"}
+}
@@ -0,0 +1,264 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AlertPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &AlertPoliciesDataSource{}
+)
+
+type AlertPoliciesDataSource struct {
+	client client.DagsterClient
+}
+
+type AlertPoliciesDataSourceModel struct {
+	AlertPolicies types.List `tfsdk:"alert_policies"`
+}
+
+var alertTargetAttributeTypes = map[string]attr.Type{
+	"asset_group":   types.StringType,
+	"location_name": types.StringType,
+	"repo_name":     types.StringType,
+	"asset_key":     types.ListType{ElemType: types.StringType},
+}
+
+var alertPolicyAttributeTypes = map[string]attr.Type{
+	"id":                        types.StringType,
+	"name":                      types.StringType,
+	"description":               types.StringType,
+	"event_types":               types.ListType{ElemType: types.StringType},
+	"enabled":                   types.BoolType,
+	"tags":                      types.MapType{ElemType: types.StringType},
+	"notification_service_type": types.StringType,
+	"alert_targets":             types.ListType{ElemType: types.ObjectType{AttrTypes: alertTargetAttributeTypes}},
+}
+
+// Notification service of an alert policy, by GraphQL type name
+var alertPolicyNotificationServiceTypes = map[string]string{
+	"EmailAlertPolicyNotification":          "email",
+	"SlackAlertPolicyNotification":          "slack",
+	"EmailOwnersAlertPolicyNotification":    "email_owners",
+	"MicrosoftTeamsAlertPolicyNotification": "microsoft_teams",
+	"PagerdutyAlertPolicyNotification":      "pagerduty",
+}
+
+//nolint:ireturn // required by Terraform API
+func NewAlertPoliciesDataSource() datasource.DataSource {
+	return &AlertPoliciesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *AlertPoliciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_policies"
+}
+
+// Schema defines the schema for the data source.
+func (d *AlertPoliciesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Retrieve all alert policies of the deployment the provider is configured with.`,
+		Attributes: map[string]schema.Attribute{
+			"alert_policies": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Alert policies",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Alert policy id",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Alert policy name",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Alert policy description",
+						},
+						"event_types": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Event types that trigger the alert policy",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the alert policy is enabled",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Tags the jobs or assets must have to trigger the alert policy",
+						},
+						"notification_service_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Where the alerts are sent to, one of email, slack, email_owners, microsoft_teams or pagerduty",
+						},
+						"alert_targets": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Assets the alert policy applies to",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"asset_group": schema.StringAttribute{
+										Computed:    true,
+										Description: "Asset group name, for asset group targets",
+									},
+									"location_name": schema.StringAttribute{
+										Computed:    true,
+										Description: "Code location of the asset group, for asset group targets",
+									},
+									"repo_name": schema.StringAttribute{
+										Computed:    true,
+										Description: "Repository of the asset group, for asset group targets",
+									},
+									"asset_key": schema.ListAttribute{
+										ElementType: types.StringType,
+										Computed:    true,
+										Description: "Path of the asset key, for asset key targets",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *AlertPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *AlertPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AlertPoliciesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertPolicies, err := d.client.AlertPoliciesClient.GetAllAlertPolicies(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get alert policies information, got error: %s", err))
+		return
+	}
+
+	alertPolicyObjects := make([]attr.Value, 0, len(alertPolicies))
+	for _, alertPolicy := range alertPolicies {
+		alertPolicyObject, diags := alertPolicyToObject(ctx, alertPolicy)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		alertPolicyObjects = append(alertPolicyObjects, alertPolicyObject)
+	}
+
+	alertPoliciesAsList, diags := types.ListValue(types.ObjectType{AttrTypes: alertPolicyAttributeTypes}, alertPolicyObjects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.AlertPolicies = alertPoliciesAsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func alertPolicyToObject(ctx context.Context, alertPolicy clientSchema.AlertPolicy) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	eventTypes := make([]string, 0, len(alertPolicy.EventTypes))
+	for _, eventType := range alertPolicy.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	eventTypesAsList, listDiags := types.ListValueFrom(ctx, types.StringType, eventTypes)
+	diags.Append(listDiags...)
+
+	tags := make(map[string]string, len(alertPolicy.Tags))
+	for _, tag := range alertPolicy.Tags {
+		tags[tag.Key] = tag.Value
+	}
+
+	tagsAsMap, mapDiags := types.MapValueFrom(ctx, types.StringType, tags)
+	diags.Append(mapDiags...)
+
+	alertTargetObjects := make([]attr.Value, 0, len(alertPolicy.AlertTargets))
+	for _, alertTarget := range alertPolicy.AlertTargets {
+		attributeValues := map[string]attr.Value{
+			"asset_group":   types.StringNull(),
+			"location_name": types.StringNull(),
+			"repo_name":     types.StringNull(),
+			"asset_key":     types.ListNull(types.StringType),
+		}
+
+		switch target := alertTarget.(type) {
+		case *clientSchema.AlertPolicyAlertTargetsAssetGroupTarget:
+			attributeValues["asset_group"] = types.StringValue(target.AssetGroup)
+			attributeValues["location_name"] = types.StringValue(target.LocationName)
+			attributeValues["repo_name"] = types.StringValue(target.RepoName)
+		case *clientSchema.AlertPolicyAlertTargetsAssetKeyTarget:
+			assetKey, listDiags := types.ListValueFrom(ctx, types.StringType, target.AssetKey.Path)
+			diags.Append(listDiags...)
+			attributeValues["asset_key"] = assetKey
+		}
+
+		alertTargetObject, objectDiags := types.ObjectValue(alertTargetAttributeTypes, attributeValues)
+		diags.Append(objectDiags...)
+
+		alertTargetObjects = append(alertTargetObjects, alertTargetObject)
+	}
+
+	alertTargetsAsList, listDiags := types.ListValue(types.ObjectType{AttrTypes: alertTargetAttributeTypes}, alertTargetObjects)
+	diags.Append(listDiags...)
+
+	if diags.HasError() {
+		return types.ObjectNull(alertPolicyAttributeTypes), diags
+	}
+
+	attributeValues := map[string]attr.Value{
+		"id":                        types.StringValue(alertPolicy.Id),
+		"name":                      types.StringValue(alertPolicy.Name),
+		"description":               types.StringValue(alertPolicy.Description),
+		"event_types":               eventTypesAsList,
+		"enabled":                   types.BoolValue(alertPolicy.Enabled),
+		"tags":                      tagsAsMap,
+		"notification_service_type": types.StringValue(alertPolicyNotificationServiceTypes[alertPolicy.NotificationService.GetTypename()]),
+		"alert_targets":             alertTargetsAsList,
+	}
+
+	alertPolicyObject, objectDiags := types.ObjectValue(alertPolicyAttributeTypes, attributeValues)
+	diags.Append(objectDiags...)
+
+	return alertPolicyObject, diags
+}
@@ -0,0 +1,51 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccAlertPoliciesConfig(name string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_alert_policy" "test" {
+  name        = "%s"
+  event_types = ["JOB_FAILURE"]
+
+  notification_service = {
+    email = {
+      email_addresses = ["test@example.com"]
+    }
+  }
+}
+
+data "dagster_alert_policies" "this" {
+  depends_on = [dagster_alert_policy.test]
+}
+`, name)
+}
+
+func TestAccAlertPolicies(t *testing.T) {
+	name := "tf-acc-alert-policies-ds-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertPoliciesConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.dagster_alert_policies.this", "alert_policies.*", map[string]string{
+						"name":                      name,
+						"enabled":                   "true",
+						"event_types.0":             "JOB_FAILURE",
+						"notification_service_type": "email",
+					}),
+				),
+			},
+		},
+	})
+}
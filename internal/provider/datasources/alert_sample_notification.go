@@ -0,0 +1,96 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AlertNotificationTestDataSource{}
+	_ datasource.DataSourceWithConfigure = &AlertNotificationTestDataSource{}
+)
+
+type AlertNotificationTestDataSource struct {
+	client client.DagsterClient
+}
+
+type AlertNotificationTestDataSourceModel struct {
+	Document types.String `tfsdk:"document"`
+	Message  types.String `tfsdk:"message"`
+}
+
+//nolint:ireturn // required by Terraform API
+func NewAlertNotificationTestDataSource() datasource.DataSource {
+	return &AlertNotificationTestDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *AlertNotificationTestDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_notification_test"
+}
+
+// Schema defines the schema for the data source.
+func (d *AlertNotificationTestDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Sends a sample notification to the notification service of an alert policy, every time the data source is read. ` +
+			`Reading the data source fails when the notification can't be delivered, e.g. because of a misconfigured Slack channel or email address.`,
+		Attributes: map[string]schema.Attribute{
+			"document": schema.StringAttribute{
+				Required:    true,
+				Description: "Alert policy as a JSON document, e.g. generated with a dagster_configuration_document. Documents in the alert_policies: [...] format are accepted when they contain exactly one alert policy.",
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "Message returned after sending the sample notification",
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *AlertNotificationTestDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read sends the sample notification.
+func (d *AlertNotificationTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AlertNotificationTestDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	message, err := d.client.AlertPoliciesClient.SendSampleNotification(ctx, json.RawMessage(data.Document.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to send sample notification, got error: %s", err))
+		return
+	}
+
+	data.Message = types.StringValue(message)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,56 @@
+package datasources_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlertNotificationTest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ProviderConfig + `
+data "dagster_alert_notification_test" "this" {
+  document = jsonencode({
+    name        = "tf-acc-alert-notification-test"
+    description = ""
+    event_types = ["JOB_FAILURE"]
+    notification_service = {
+      email = {
+        email_addresses = ["test@example.com"]
+      }
+    }
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dagster_alert_notification_test.this", "message"),
+				),
+			},
+			// Misconfigured notification services fail the plan
+			{
+				Config: testutils.ProviderConfig + `
+data "dagster_alert_notification_test" "this" {
+  document = jsonencode({
+    name        = "tf-acc-alert-notification-test"
+    description = ""
+    event_types = ["JOB_FAILURE"]
+    notification_service = {
+      slack = {
+        slack_workspace_name = "tf-acc-workspace-that-does-not-exist"
+        slack_channel_name   = "tf-acc-channel-that-does-not-exist"
+      }
+    }
+  })
+}
+`,
+				ExpectError: regexp.MustCompile(`Unable to send sample notification`),
+			},
+		},
+	})
+}
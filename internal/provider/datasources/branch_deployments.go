@@ -0,0 +1,191 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &BranchDeploymentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &BranchDeploymentsDataSource{}
+)
+
+type BranchDeploymentsDataSource struct {
+	client client.DagsterClient
+}
+
+type BranchDeploymentsDataSourceModel struct {
+	PullRequestStatus types.String `tfsdk:"pull_request_status"`
+	BranchDeployments types.List   `tfsdk:"branch_deployments"`
+}
+
+//nolint:ireturn // required by Terraform API
+func NewBranchDeploymentsDataSource() datasource.DataSource {
+	return &BranchDeploymentsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *BranchDeploymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_deployments"
+}
+
+var branchDeploymentAttributeTypes = map[string]attr.Type{
+	"id":                  types.Int64Type,
+	"name":                types.StringType,
+	"status":              types.StringType,
+	"repo_name":           types.StringType,
+	"branch_name":         types.StringType,
+	"branch_url":          types.StringType,
+	"pull_request_url":    types.StringType,
+	"pull_request_number": types.StringType,
+	"pull_request_status": types.StringType,
+	"commit_hash":         types.StringType,
+}
+
+// Schema defines the schema for the data source.
+func (d *BranchDeploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Retrieve information about the Dagster Cloud branch deployments.`,
+		Attributes: map[string]schema.Attribute{
+			"pull_request_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return branch deployments with this pull request status (`OPEN`, `CLOSED` or `MERGED`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.PullRequestStatusEnumValues()...),
+				},
+			},
+			"branch_deployments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Branch deployments",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":     deploymentAttributes["id"],
+						"name":   deploymentAttributes["name"],
+						"status": deploymentAttributes["status"],
+						"repo_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the repository",
+						},
+						"branch_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the branch",
+						},
+						"branch_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of the branch",
+						},
+						"pull_request_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of the pull request",
+						},
+						"pull_request_number": schema.StringAttribute{
+							Computed:    true,
+							Description: "Number of the pull request",
+						},
+						"pull_request_status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the pull request",
+						},
+						"commit_hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the latest commit",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *BranchDeploymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BranchDeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BranchDeploymentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var pullRequestStatus *clientSchema.PullRequestStatus
+	if !data.PullRequestStatus.IsNull() {
+		status, err := clientTypes.ConvertToPullRequestStatusEnum(data.PullRequestStatus.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid pull request status", err.Error())
+			return
+		}
+		pullRequestStatus = &status
+	}
+
+	branchDeployments, err := d.client.DeploymentClient.GetBranchDeployments(ctx, pullRequestStatus)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get branch deployments information, got error: %s", err))
+		return
+	}
+
+	branchDeploymentObjects := make([]attr.Value, 0, len(branchDeployments))
+	for _, branchDeployment := range branchDeployments {
+		gitMetadata := branchDeployment.BranchDeploymentGitMetadata
+		attributeValues := map[string]attr.Value{
+			"id":                  types.Int64Value(int64(branchDeployment.DeploymentId)),
+			"name":                types.StringValue(branchDeployment.DeploymentName),
+			"status":              types.StringValue(string(branchDeployment.DeploymentStatus)),
+			"repo_name":           types.StringValue(gitMetadata.RepoName),
+			"branch_name":         types.StringValue(gitMetadata.BranchName),
+			"branch_url":          types.StringValue(gitMetadata.BranchUrl),
+			"pull_request_url":    types.StringValue(gitMetadata.PullRequestUrl),
+			"pull_request_number": types.StringValue(gitMetadata.PullRequestNumber),
+			"pull_request_status": types.StringValue(string(gitMetadata.PullRequestStatus)),
+			"commit_hash":         types.StringValue(branchDeployment.LatestCommit.CommitHash),
+		}
+
+		branchDeploymentObject, diag := types.ObjectValue(branchDeploymentAttributeTypes, attributeValues)
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		branchDeploymentObjects = append(branchDeploymentObjects, branchDeploymentObject)
+	}
+
+	branchDeploymentsAsList, diag := types.ListValue(types.ObjectType{AttrTypes: branchDeploymentAttributeTypes}, branchDeploymentObjects)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.BranchDeployments = branchDeploymentsAsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,54 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccBranchDeploymentsConfig(branchName string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_branch_deployment" "this" {
+  repo_name   = "datarootsio/terraform-provider-dagster"
+  branch_name = "%s"
+
+  commit = {
+    hash = "0000000000000000000000000000000000000001"
+  }
+}
+
+data "dagster_branch_deployments" "open" {
+  pull_request_status = "OPEN"
+
+  depends_on = [dagster_branch_deployment.this]
+}
+
+locals {
+  branch_names = [for branch_deployment in data.dagster_branch_deployments.open.branch_deployments : branch_deployment.branch_name]
+}
+
+output "contains_branch" {
+  value = contains(local.branch_names, dagster_branch_deployment.this.branch_name)
+}
+`, branchName)
+}
+
+func TestAccBranchDeployments(t *testing.T) {
+	branchName := "tf-acc-branch-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchDeploymentsConfig(branchName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("contains_branch", "true"),
+				),
+			},
+		},
+	})
+}
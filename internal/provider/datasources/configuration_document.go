@@ -5,8 +5,12 @@ import (
 	"fmt"
 
 	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"sigs.k8s.io/yaml"
 )
@@ -22,10 +26,17 @@ type ConfigurationDocumentDataSource struct {
 }
 
 type ConfigurationDocumentDataSourceModel struct {
-	YAMLBody types.String `tfsdk:"yaml_body"`
-	JSONBody types.String `tfsdk:"json"`
+	YAMLBody     types.String `tfsdk:"yaml_body"`
+	JSONBody     types.String `tfsdk:"json"`
+	DocumentType types.String `tfsdk:"document_type"`
 }
 
+const (
+	documentTypeDeploymentSettings = "deployment_settings"
+	documentTypeCodeLocation       = "code_location"
+	documentTypeWorkspace          = "workspace"
+)
+
 func NewConfigurationDocumentDataSource() datasource.DataSource {
 	return &ConfigurationDocumentDataSource{}
 }
@@ -48,6 +59,13 @@ func (d *ConfigurationDocumentDataSource) Schema(_ context.Context, _ datasource
 				Computed:    true,
 				Description: "Settings document as JSON document",
 			},
+			"document_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Type of the document, the document is validated against the schema of Dagster Cloud for this type. One of `deployment_settings`, `code_location` or `workspace` (multiple code locations in the `locations: [...]` format)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(documentTypeDeploymentSettings, documentTypeCodeLocation, documentTypeWorkspace),
+				},
+			},
 		},
 	}
 }
@@ -85,6 +103,34 @@ func (d *ConfigurationDocumentDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
+	if !data.DocumentType.IsNull() {
+		configErrors, err := d.validateDocument(ctx, data.DocumentType.ValueString(), json)
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(path.Root("yaml_body"), "Unable to validate document", fmt.Sprintf("Unable to fetch the %s schema, got error: %s", data.DocumentType.ValueString(), err))
+		}
+		for _, configError := range configErrors {
+			resp.Diagnostics.AddAttributeError(path.Root("yaml_body"), "Invalid document", fmt.Sprintf("Invalid %s document, %s", data.DocumentType.ValueString(), configError.Error()))
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	data.JSONBody = types.StringValue(string(json))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// validateDocument checks the document against the schema of its type in Dagster Cloud
+func (d *ConfigurationDocumentDataSource) validateDocument(ctx context.Context, documentType string, document []byte) ([]clientTypes.ConfigError, error) {
+	switch documentType {
+	case documentTypeDeploymentSettings:
+		// Documents can be merged into the existing settings, so required settings may be left out
+		return d.client.DeploymentClient.ValidateDeploymentSettings(ctx, document, true)
+	case documentTypeCodeLocation:
+		return d.client.CodeLocationsClient.ValidateCodeLocationDocument(ctx, document)
+	case documentTypeWorkspace:
+		return d.client.CodeLocationsClient.ValidateWorkspaceDocument(ctx, document)
+	default:
+		return nil, nil
+	}
+}
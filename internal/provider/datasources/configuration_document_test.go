@@ -72,3 +72,23 @@ data "dagster_configuration_document" "this" {
 		},
 	})
 }
+
+func TestAccResourceInvalidDeploymentSettingsConfigurationDocument(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ProviderConfig + `
+data "dagster_configuration_document" "this" {
+  document_type = "deployment_settings"
+  yaml_body     = <<YAML
+run_queue:
+  max_concurent_runs: 10
+YAML
+}`,
+				ExpectError: regexp.MustCompile(`run_queue.max_concurent_runs: unexpected key`),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DeploymentDataSource{}
+	_ datasource.DataSourceWithConfigure = &DeploymentDataSource{}
+)
+
+type DeploymentDataSource struct {
+	client client.DagsterClient
+}
+
+type DeploymentDataSourceModel struct {
+	Id               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Status           types.String `tfsdk:"status"`
+	Type             types.String `tfsdk:"type"`
+	AgentType        types.String `tfsdk:"agent_type"`
+	SettingsDocument types.String `tfsdk:"settings_document"`
+}
+
+//nolint:ireturn // required by Terraform API
+func NewDeploymentDataSource() datasource.DataSource {
+	return &DeploymentDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DeploymentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+var deploymentAttributes = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
+		Computed:    true,
+		Description: "Deployment id",
+	},
+	"name": schema.StringAttribute{
+		Computed:    true,
+		Description: "Deployment name",
+	},
+	"status": schema.StringAttribute{
+		Computed:    true,
+		Description: "Deployment status (`ACTIVE` or `PENDING_DELETION`)",
+	},
+	"type": schema.StringAttribute{
+		Computed:    true,
+		Description: "Deployment type (`PRODUCTION`, `DEV` or `BRANCH`)",
+	},
+	"agent_type": schema.StringAttribute{
+		Computed:    true,
+		Description: "Agent type of the deployment (`HYBRID` or `SERVERLESS`)",
+	},
+}
+
+var deploymentAttributeTypes = map[string]attr.Type{
+	"id":         types.Int64Type,
+	"name":       types.StringType,
+	"status":     types.StringType,
+	"type":       types.StringType,
+	"agent_type": types.StringType,
+}
+
+// Schema defines the schema for the data source.
+func (d *DeploymentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Retrieve information about a Dagster Cloud deployment.`,
+		Attributes: map[string]schema.Attribute{
+			"id":         deploymentAttributes["id"],
+			"status":     deploymentAttributes["status"],
+			"type":       deploymentAttributes["type"],
+			"agent_type": deploymentAttributes["agent_type"],
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the Dagster Cloud deployment",
+			},
+			"settings_document": schema.StringAttribute{
+				Computed:    true,
+				Description: "Settings of the deployment as a JSON document",
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *DeploymentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := d.client.DeploymentClient.GetDeploymentByName(ctx, data.Name.ValueString())
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			resp.Diagnostics.AddError("Deployment not found", fmt.Sprintf("No deployment with name %s exists", data.Name.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get deployment information, got error: %s", err))
+		}
+		return
+	}
+
+	settings, err := utils.MakeJSONStringUniform(deployment.DeploymentSettings.Settings)
+	if err != nil {
+		resp.Diagnostics.AddError("JSON Format error", fmt.Sprintf("Unable to format deployment settings, got error: %s", err))
+		return
+	}
+
+	data.Id = types.Int64Value(int64(deployment.DeploymentId))
+	data.Name = types.StringValue(deployment.DeploymentName)
+	data.Status = types.StringValue(string(deployment.DeploymentStatus))
+	data.Type = types.StringValue(string(deployment.DeploymentType))
+	data.AgentType = types.StringValue(string(deployment.AgentType))
+	data.SettingsDocument = types.StringValue(settings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// deploymentAttributeValues returns the attribute values of a deployment in a list of deployments
+func deploymentAttributeValues(deployment clientSchema.Deployment) map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":         types.Int64Value(int64(deployment.DeploymentId)),
+		"name":       types.StringValue(deployment.DeploymentName),
+		"status":     types.StringValue(string(deployment.DeploymentStatus)),
+		"type":       types.StringValue(string(deployment.DeploymentType)),
+		"agent_type": types.StringValue(string(deployment.AgentType)),
+	}
+}
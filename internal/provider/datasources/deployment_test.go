@@ -0,0 +1,37 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccDeploymentConfig() string {
+	return testutils.ProviderConfig + `
+data "dagster_current_deployment" "this" {}
+
+data "dagster_deployment" "this" {
+  name = var.testing_dagster_deployment
+}
+`
+}
+
+func TestAccDeployment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.dagster_deployment.this", "id", "data.dagster_current_deployment.this", "id"),
+					resource.TestCheckResourceAttrPair("data.dagster_deployment.this", "name", "data.dagster_current_deployment.this", "name"),
+					resource.TestCheckResourceAttr("data.dagster_deployment.this", "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet("data.dagster_deployment.this", "type"),
+					resource.TestCheckResourceAttrSet("data.dagster_deployment.this", "settings_document"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,136 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DeploymentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DeploymentsDataSource{}
+)
+
+type DeploymentsDataSource struct {
+	client client.DagsterClient
+}
+
+type DeploymentsDataSourceModel struct {
+	DeploymentType   types.String `tfsdk:"deployment_type"`
+	DeploymentStatus types.String `tfsdk:"deployment_status"`
+	Deployments      types.List   `tfsdk:"deployments"`
+}
+
+//nolint:ireturn // required by Terraform API
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DeploymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+// Schema defines the schema for the data source.
+func (d *DeploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Retrieve information about the Dagster Cloud deployments.`,
+		Attributes: map[string]schema.Attribute{
+			"deployment_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return deployments of this type (`PRODUCTION`, `DEV` or `BRANCH`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.DeploymentTypeEnumValues()...),
+				},
+			},
+			"deployment_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return deployments with this status (`ACTIVE` or `PENDING_DELETION`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.DeploymentStatusEnumValues()...),
+				},
+			},
+			"deployments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Deployments",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: deploymentAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *DeploymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployments, err := d.client.DeploymentClient.GetAllDeployments(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get deployments information, got error: %s", err))
+		return
+	}
+
+	deploymentObjects := make([]attr.Value, 0, len(deployments))
+	for _, deployment := range deployments {
+		if !data.DeploymentType.IsNull() && string(deployment.DeploymentType) != data.DeploymentType.ValueString() {
+			continue
+		}
+		if !data.DeploymentStatus.IsNull() && string(deployment.DeploymentStatus) != data.DeploymentStatus.ValueString() {
+			continue
+		}
+
+		deploymentObject, diag := types.ObjectValue(deploymentAttributeTypes, deploymentAttributeValues(deployment))
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		deploymentObjects = append(deploymentObjects, deploymentObject)
+	}
+
+	deploymentsAsList, diag := types.ListValue(types.ObjectType{AttrTypes: deploymentAttributeTypes}, deploymentObjects)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Deployments = deploymentsAsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
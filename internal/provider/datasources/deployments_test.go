@@ -0,0 +1,37 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccDeploymentsConfig(deploymentType string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+data "dagster_deployments" "all" {}
+
+data "dagster_deployments" "filtered" {
+  deployment_type   = "%s"
+  deployment_status = "ACTIVE"
+}
+`, deploymentType)
+}
+
+func TestAccDeployments(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentsConfig("PRODUCTION"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dagster_deployments.all", "deployments.0.id"),
+					resource.TestCheckResourceAttr("data.dagster_deployments.filtered", "deployments.0.type", "PRODUCTION"),
+					resource.TestCheckResourceAttr("data.dagster_deployments.filtered", "deployments.0.status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,96 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SlackChannelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SlackChannelsDataSource{}
+)
+
+type SlackChannelsDataSource struct {
+	client client.DagsterClient
+}
+
+type SlackChannelsDataSourceModel struct {
+	Channels types.List `tfsdk:"channels"`
+}
+
+//nolint:ireturn // required by Terraform API
+func NewSlackChannelsDataSource() datasource.DataSource {
+	return &SlackChannelsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *SlackChannelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slack_channels"
+}
+
+// Schema defines the schema for the data source.
+func (d *SlackChannelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Retrieve the Slack channels the Dagster Cloud Slack app can post alerts to.`,
+		Attributes: map[string]schema.Attribute{
+			"channels": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Names of the available Slack channels",
+			},
+		},
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *SlackChannelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SlackChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SlackChannelsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channels, err := d.client.AlertPoliciesClient.GetAvailableSlackChannels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Slack channels, got error: %s", err))
+		return
+	}
+
+	channelsAsList, diags := types.ListValueFrom(ctx, types.StringType, channels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Channels = channelsAsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,103 @@
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringValuableWithSemanticEquals = Document{}
+
+// Document is a JSON document that is semantically equal to a document returned by Dagster Cloud when the
+// returned document contains all declared keys with the same values. Key order, formatting of numbers and
+// defaults filled in by Dagster Cloud for keys that are not declared are ignored.
+type Document struct {
+	basetypes.StringValue
+}
+
+func NewDocumentValue(value string) Document {
+	return Document{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewDocumentNull() Document {
+	return Document{StringValue: basetypes.NewStringNull()}
+}
+
+func NewDocumentUnknown() Document {
+	return Document{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v Document) Type(ctx context.Context) attr.Type {
+	return DocumentType{}
+}
+
+func (v Document) Equal(o attr.Value) bool {
+	other, ok := o.(Document)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals checks whether the new document (v) contains everything the prior document declares
+func (v Document) StringSemanticEquals(ctx context.Context, priorValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	prior, ok := priorValuable.(Document)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected value type %T, got: %T", v, priorValuable))
+		return false, diags
+	}
+
+	var declared, actual interface{}
+	if err := json.Unmarshal([]byte(prior.ValueString()), &declared); err != nil {
+		return false, diags
+	}
+	if err := json.Unmarshal([]byte(v.ValueString()), &actual); err != nil {
+		return false, diags
+	}
+
+	return containsDeclared(declared, actual), diags
+}
+
+// containsDeclared checks whether the actual value contains all keys of the declared value with equal values,
+// objects are compared recursively, also when they are part of an array
+func containsDeclared(declared interface{}, actual interface{}) bool {
+	switch declaredCast := declared.(type) {
+	case map[string]interface{}:
+		actualCast, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for key, declaredValue := range declaredCast {
+			actualValue, ok := actualCast[key]
+			if !ok || !containsDeclared(declaredValue, actualValue) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		actualCast, ok := actual.([]interface{})
+		if !ok || len(declaredCast) != len(actualCast) {
+			return false
+		}
+
+		for i := range declaredCast {
+			if !containsDeclared(declaredCast[i], actualCast[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(declared, actual)
+	}
+}
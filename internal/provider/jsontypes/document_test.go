@@ -0,0 +1,76 @@
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/provider/jsontypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentSemanticEquals(t *testing.T) {
+	testCases := map[string]struct {
+		declared string
+		actual   string
+		expected bool
+	}{
+		"identical": {
+			declared: `{"a": 1}`,
+			actual:   `{"a": 1}`,
+			expected: true,
+		},
+		"key order and whitespace": {
+			declared: `{"a": 1, "b": "x"}`,
+			actual:   `{"b":"x","a":1}`,
+			expected: true,
+		},
+		"number formatting": {
+			declared: `{"a": 1200}`,
+			actual:   `{"a": 1.2e3}`,
+			expected: true,
+		},
+		"server-applied defaults": {
+			declared: `{"run_queue": {"max_concurrent_runs": 30}}`,
+			actual:   `{"run_queue": {"max_concurrent_runs": 30, "tag_concurrency_limits": []}, "sso_default_role": "VIEWER"}`,
+			expected: true,
+		},
+		"empty declared document": {
+			declared: `{}`,
+			actual:   `{"sso_default_role": "VIEWER"}`,
+			expected: true,
+		},
+		"changed value": {
+			declared: `{"run_queue": {"max_concurrent_runs": 30}}`,
+			actual:   `{"run_queue": {"max_concurrent_runs": 20}}`,
+			expected: false,
+		},
+		"missing key": {
+			declared: `{"a": 1, "b": 2}`,
+			actual:   `{"a": 1}`,
+			expected: false,
+		},
+		"defaults in arrays": {
+			declared: `{"limits": [{"key": "a"}]}`,
+			actual:   `{"limits": [{"key": "a", "limit": 1}]}`,
+			expected: true,
+		},
+		"different array length": {
+			declared: `{"limits": [{"key": "a"}]}`,
+			actual:   `{"limits": [{"key": "a"}, {"key": "b"}]}`,
+			expected: false,
+		},
+		"invalid JSON": {
+			declared: `{"a": 1}`,
+			actual:   `not json`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := jsontypes.NewDocumentValue(testCase.actual).StringSemanticEquals(context.Background(), jsontypes.NewDocumentValue(testCase.declared))
+			assert.False(t, diags.HasError())
+			assert.Equal(t, testCase.expected, equal)
+		})
+	}
+}
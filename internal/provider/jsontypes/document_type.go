@@ -0,0 +1,81 @@
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = DocumentType{}
+	_ xattr.TypeWithValidate  = DocumentType{}
+)
+
+// DocumentType is an attribute type for JSON documents of which Dagster Cloud fills in defaults, see Document
+type DocumentType struct {
+	basetypes.StringType
+}
+
+func (t DocumentType) String() string {
+	return "jsontypes.DocumentType"
+}
+
+func (t DocumentType) ValueType(ctx context.Context) attr.Value {
+	return Document{}
+}
+
+func (t DocumentType) Equal(o attr.Type) bool {
+	other, ok := o.(DocumentType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t DocumentType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Document{StringValue: in}, nil
+}
+
+func (t DocumentType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return Document{StringValue: stringValue}, nil
+}
+
+// Validate checks that the document is a JSON object
+func (t DocumentType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(path, "Invalid JSON document", fmt.Sprintf("Unable to read the document as a string: %s", err))
+		return diags
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &document); err != nil {
+		diags.AddAttributeError(path, "Invalid JSON document", fmt.Sprintf("The document is not a JSON object: %s", err))
+	}
+
+	return diags
+}
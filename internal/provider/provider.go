@@ -161,6 +161,12 @@ func (p *DagsterProvider) DataSources(_ context.Context) []func() datasource.Dat
 		datasources.NewTeamsDataSource,
 		datasources.NewVersionDataSource,
 		datasources.NewOrganizationDataSource,
+		datasources.NewAlertNotificationTestDataSource,
+		datasources.NewAlertPoliciesDataSource,
+		datasources.NewSlackChannelsDataSource,
+		datasources.NewDeploymentDataSource,
+		datasources.NewDeploymentsDataSource,
+		datasources.NewBranchDeploymentsDataSource,
 	}
 }
 
@@ -174,5 +180,19 @@ func (p *DagsterProvider) Resources(_ context.Context) []func() resource.Resourc
 		resources.NewCodeLocationResource,
 		resources.NewDeploymentResource,
 		resources.NewCodeLocationFromDocumentResource,
+		resources.NewSecretResource,
+		resources.NewSecretsResource,
+		resources.NewAlertPolicyResource,
+		resources.NewAlertPoliciesResource,
+		resources.NewAgentTokenResource,
+		resources.NewAgentTokenGrantResource,
+		resources.NewUserTokenResource,
+		resources.NewApiTokenResource,
+		resources.NewSSHKeyResource,
+		resources.NewBranchDeploymentResource,
+		resources.NewDeploymentSettingsResource,
+		resources.NewUserDeploymentGrantResource,
+		resources.NewTeamMembersResource,
+		resources.NewTeamDeploymentGrantsResource,
 	}
 }
@@ -0,0 +1,375 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &AgentTokenResource{}
+	_ resource.ResourceWithImportState = &AgentTokenResource{}
+	_ resource.ResourceWithModifyPlan  = &AgentTokenResource{}
+)
+
+func NewAgentTokenResource() resource.Resource {
+	return &AgentTokenResource{}
+}
+
+type AgentTokenResource struct {
+	client client.DagsterClient
+}
+
+type AgentTokenResourceModel struct {
+	Id              types.Int64   `tfsdk:"id"`
+	Description     types.String  `tfsdk:"description"`
+	Token           types.String  `tfsdk:"token"`
+	CreateTimestamp types.Float64 `tfsdk:"create_timestamp"`
+	RotationDays    types.Int64   `tfsdk:"rotation_days"`
+	Keepers         types.Map     `tfsdk:"keepers"`
+	GraceDays       types.Int64   `tfsdk:"previous_token_grace_days"`
+	PreviousId      types.Int64   `tfsdk:"previous_token_id"`
+	PreviousToken   types.String  `tfsdk:"previous_token"`
+}
+
+func (r *AgentTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_token"
+}
+
+func (r *AgentTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates an agent token. The token is rotated after `rotation_days` or when `keepers` change: " +
+			"a new token is created and the old token is kept as `previous_token`, so agents can switch to the new token without downtime. " +
+			"The previous token is revoked on the next rotation, or after `previous_token_grace_days` when set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Agent token id, changes when the token is rotated",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Agent token description. DEFAULT `\"\"`",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Agent token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_timestamp": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the creation of the current token",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_days": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Number of days after which the token is rotated. The rotation happens on the first apply after the period has passed.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary map of values that rotates the token when it changes",
+			},
+			"previous_token_grace_days": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Number of days after a rotation after which the previous token is revoked. The revocation happens on the first apply after the period has passed. When not set, the previous token is revoked on the next rotation.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"previous_token_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Id of the token that was replaced by the last rotation, as long as it isn't revoked",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Token that was replaced by the last rotation, as long as it isn't revoked",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AgentTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans a rotation of the token when the keepers changed or the rotation period has passed,
+// and plans the revocation of the previous token when its grace period has passed.
+// Both are done in place by Update, which keeps the old token as previous token on a rotation.
+func (r *AgentTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AgentTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case tokenNeedsRotation(plan.Keepers, state.Keepers, plan.RotationDays, state.CreateTimestamp):
+		plan.Id = types.Int64Unknown()
+		plan.Token = types.StringUnknown()
+		plan.CreateTimestamp = types.Float64Unknown()
+		plan.PreviousId = types.Int64Unknown()
+		plan.PreviousToken = types.StringUnknown()
+	case !state.PreviousId.IsNull() && periodHasPassed(plan.GraceDays, state.CreateTimestamp):
+		plan.PreviousId = types.Int64Null()
+		plan.PreviousToken = types.StringNull()
+	default:
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *AgentTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AgentTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.TokensClient.CreateAgentToken(ctx, data.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create agent token, got error: %s", err))
+		return
+	}
+
+	setAgentTokenResourceModel(&data, token)
+	data.PreviousId = types.Int64Null()
+	data.PreviousToken = types.StringNull()
+
+	tflog.Trace(ctx, fmt.Sprintf("created agent token with id: %d", token.Id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgentTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AgentTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.TokensClient.GetAgentTokenById(ctx, int(data.Id.ValueInt64()))
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Agent token not found, probably already revoked manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read agent token, got error: %s", err))
+		}
+		return
+	}
+
+	setAgentTokenResourceModel(&data, token)
+
+	// The previous token is dropped from state once it's revoked
+	if !data.PreviousId.IsNull() {
+		_, err := r.client.TokensClient.GetAgentTokenById(ctx, int(data.PreviousId.ValueInt64()))
+		if err != nil {
+			var errComp *clientTypes.ErrNotFound
+			if !errors.As(err, &errComp) {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read previous agent token, got error: %s", err))
+				return
+			}
+
+			data.PreviousId = types.Int64Null()
+			data.PreviousToken = types.StringNull()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgentTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AgentTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The previous token is revoked on a rotation or when its grace period has passed
+	if !state.PreviousId.IsNull() && (data.Id.IsUnknown() || data.PreviousId.IsNull()) {
+		err := r.client.TokensClient.RevokeAgentToken(ctx, int(state.PreviousId.ValueInt64()))
+		var errComp *clientTypes.ErrNotFound
+		if err != nil && !errors.As(err, &errComp) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke previous agent token %d, got error: %s", state.PreviousId.ValueInt64(), err))
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("revoked previous agent token %d", state.PreviousId.ValueInt64()))
+
+		data.PreviousId = types.Int64Null()
+		data.PreviousToken = types.StringNull()
+	}
+
+	// A rotation is planned, the old token stays valid as previous token so agents can switch without downtime
+	if data.Id.IsUnknown() {
+		token, err := r.client.TokensClient.CreateAgentToken(ctx, data.Description.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate agent token, got error: %s", err))
+			return
+		}
+
+		setAgentTokenResourceModel(&data, token)
+		data.PreviousId = state.Id
+		data.PreviousToken = state.Token
+
+		tflog.Trace(ctx, fmt.Sprintf("rotated agent token %d to %d", state.Id.ValueInt64(), token.Id))
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	token, err := r.client.TokensClient.EditAgentTokenDescription(ctx, int(data.Id.ValueInt64()), data.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update agent token, got error: %s", err))
+		return
+	}
+
+	setAgentTokenResourceModel(&data, token)
+
+	tflog.Trace(ctx, "updated agent token resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgentTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AgentTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.TokensClient.RevokeAgentToken(ctx, int(data.Id.ValueInt64()))
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Agent token not found, probably already revoked manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke agent token, got error: %s", err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("revoked agent token with id: %d", data.Id.ValueInt64()))
+
+	if !data.PreviousId.IsNull() {
+		err := r.client.TokensClient.RevokeAgentToken(ctx, int(data.PreviousId.ValueInt64()))
+		var errComp *clientTypes.ErrNotFound
+		if err != nil && !errors.As(err, &errComp) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke previous agent token, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("revoked previous agent token with id: %d", data.PreviousId.ValueInt64()))
+	}
+}
+
+func (r *AgentTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", fmt.Sprintf("Expected the numeric id of the agent token, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func setAgentTokenResourceModel(data *AgentTokenResourceModel, token clientSchema.AgentToken) {
+	data.Id = types.Int64Value(int64(token.Id))
+	data.Description = types.StringValue(token.Description)
+	data.Token = types.StringValue(token.Token)
+	data.CreateTimestamp = types.Float64Value(token.CreateTimestamp)
+}
+
+// tokenNeedsRotation checks whether the keepers changed or the rotation period of a token has passed.
+// Tokens without keepers in state, like imported tokens, adopt the planned keepers without a rotation.
+func tokenNeedsRotation(planKeepers types.Map, stateKeepers types.Map, rotationDays types.Int64, createTimestamp types.Float64) bool {
+	if !stateKeepers.IsNull() && !planKeepers.Equal(stateKeepers) {
+		return true
+	}
+
+	return periodHasPassed(rotationDays, createTimestamp)
+}
+
+// periodHasPassed checks whether a period of days has passed since the creation of a token
+func periodHasPassed(days types.Int64, createTimestamp types.Float64) bool {
+	if days.IsNull() || days.IsUnknown() || createTimestamp.IsNull() || createTimestamp.IsUnknown() {
+		return false
+	}
+
+	createdAt := time.Unix(int64(createTimestamp.ValueFloat64()), 0)
+	period := time.Duration(days.ValueInt64()) * 24 * time.Hour
+
+	return time.Now().After(createdAt.Add(period))
+}
@@ -0,0 +1,358 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &AgentTokenGrantResource{}
+	_ resource.ResourceWithImportState    = &AgentTokenGrantResource{}
+	_ resource.ResourceWithModifyPlan     = &AgentTokenGrantResource{}
+	_ resource.ResourceWithValidateConfig = &AgentTokenGrantResource{}
+)
+
+func NewAgentTokenGrantResource() resource.Resource {
+	return &AgentTokenGrantResource{}
+}
+
+type AgentTokenGrantResource struct {
+	client client.DagsterClient
+}
+
+type AgentTokenGrantResourceModel struct {
+	Id              types.Int64  `tfsdk:"id"`
+	AgentTokenId    types.Int64  `tfsdk:"agent_token_id"`
+	DeploymentScope types.String `tfsdk:"deployment_scope"`
+	DeploymentId    types.Int64  `tfsdk:"deployment_id"`
+	Grant           types.String `tfsdk:"grant"`
+}
+
+func (r *AgentTokenGrantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_token_grant"
+}
+
+func (r *AgentTokenGrantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants an agent token permissions on a deployment, on all branch deployments or on the organization. " +
+			"Agent tokens without grants have access to all deployments of the organization. " +
+			"When `agent_token_id` changes, for example when a `dagster_agent_token` is rotated, the grant is kept on the previous agent token until that token is revoked, " +
+			"so a previous token that is still valid during `previous_token_grace_days` doesn't gain access to all deployments.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Agent token grant id",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"agent_token_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the agent token",
+			},
+			"deployment_scope": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(clientSchema.PermissionDeploymentScopeDeployment)),
+				MarkdownDescription: "Scope of the grant, one of `" + strings.Join(clientTypes.DeploymentScopeEnumValues(), "`, `") + "`. DEFAULT `DEPLOYMENT`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.DeploymentScopeEnumValues()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployment_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Id of the deployment, required when `deployment_scope` is `DEPLOYMENT`",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"grant": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Grant of the agent token, one of `" + strings.Join(clientTypes.AgentGrantEnumValues(), "`, `") + "`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.AgentGrantEnumValues()...),
+				},
+			},
+		},
+	}
+}
+
+func (r *AgentTokenGrantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures a deployment id is given for, and only for, the DEPLOYMENT scope
+func (r *AgentTokenGrantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AgentTokenGrantResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeploymentScope.IsUnknown() || data.DeploymentId.IsUnknown() {
+		return
+	}
+
+	isDeploymentScope := data.DeploymentScope.IsNull() || data.DeploymentScope.ValueString() == string(clientSchema.PermissionDeploymentScopeDeployment)
+
+	if isDeploymentScope && data.DeploymentId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deployment_id"),
+			"Missing deployment_id",
+			"deployment_id is required when deployment_scope is DEPLOYMENT",
+		)
+	}
+
+	if !isDeploymentScope && !data.DeploymentId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deployment_id"),
+			"Invalid deployment_id",
+			fmt.Sprintf("deployment_id can't be set when deployment_scope is %s", data.DeploymentScope.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan plans a new grant id when the grant moves to another agent token
+func (r *AgentTokenGrantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AgentTokenGrantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AgentTokenId.Equal(state.AgentTokenId) {
+		plan.Id = types.Int64Unknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+}
+
+func (r *AgentTokenGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AgentTokenGrantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grant, err := r.createOrUpdateAgentTokenGrant(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create agent token grant, got error: %s", err))
+		return
+	}
+
+	data.Id = types.Int64Value(int64(grant.Id))
+
+	tflog.Trace(ctx, "created agent token grant resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgentTokenGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AgentTokenGrantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope, err := clientTypes.ConvertToDeploymentScopeEnum(data.DeploymentScope.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read agent token grant, got error: %s", err))
+		return
+	}
+
+	grant, err := r.client.TokensClient.GetAgentTokenGrant(
+		ctx,
+		int(data.AgentTokenId.ValueInt64()),
+		scope,
+		int(data.DeploymentId.ValueInt64()),
+	)
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Agent token grant not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read agent token grant, got error: %s", err))
+		}
+		return
+	}
+
+	data.Id = types.Int64Value(int64(grant.Id))
+	data.Grant = types.StringValue(string(grant.Grant))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgentTokenGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AgentTokenGrantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grant, err := r.createOrUpdateAgentTokenGrant(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update agent token grant, got error: %s", err))
+		return
+	}
+
+	data.Id = types.Int64Value(int64(grant.Id))
+
+	// The grant isn't removed from the previous agent token, it is revoked together with that token
+	if !data.AgentTokenId.Equal(state.AgentTokenId) {
+		tflog.Trace(ctx, fmt.Sprintf("kept the grant on previous agent token with id: %d", state.AgentTokenId.ValueInt64()))
+	}
+
+	tflog.Trace(ctx, "updated agent token grant resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgentTokenGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AgentTokenGrantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope, err := clientTypes.ConvertToDeploymentScopeEnum(data.DeploymentScope.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete agent token grant, got error: %s", err))
+		return
+	}
+
+	err = r.client.TokensClient.RemoveAgentTokenGrant(
+		ctx,
+		int(data.AgentTokenId.ValueInt64()),
+		scope,
+		int(data.DeploymentId.ValueInt64()),
+	)
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Agent token grant not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete agent token grant, got error: %s", err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted agent token grant resource with AgentTokenId=%d DeploymentScope=%s", data.AgentTokenId.ValueInt64(), data.DeploymentScope.ValueString()))
+}
+
+// ImportState imports a grant by `agent_token_id/deployment_id`, or `agent_token_id/deployment_scope` for the other scopes
+func (r *AgentTokenGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tokenIdString, scopeOrDeploymentId, ok := strings.Cut(req.ID, "/")
+
+	tokenId, err := strconv.ParseInt(tokenIdString, 10, 64)
+	if !ok || err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import id",
+			fmt.Sprintf("Expected an import id like agent_token_id/deployment_id or agent_token_id/deployment_scope, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("agent_token_id"), tokenId)...)
+
+	deploymentId, err := strconv.ParseInt(scopeOrDeploymentId, 10, 64)
+	if err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_scope"), string(clientSchema.PermissionDeploymentScopeDeployment))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentId)...)
+		return
+	}
+
+	scope, err := clientTypes.ConvertToDeploymentScopeEnum(scopeOrDeploymentId)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", fmt.Sprintf("Unable to import agent token grant, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_scope"), string(scope))...)
+}
+
+func (r *AgentTokenGrantResource) createOrUpdateAgentTokenGrant(ctx context.Context, data AgentTokenGrantResourceModel) (clientSchema.ScopedPermissionGrant, error) {
+	grant, err := clientTypes.ConvertToGrantEnum(data.Grant.ValueString())
+	if err != nil {
+		return clientSchema.ScopedPermissionGrant{}, err
+	}
+
+	scope, err := clientTypes.ConvertToDeploymentScopeEnum(data.DeploymentScope.ValueString())
+	if err != nil {
+		return clientSchema.ScopedPermissionGrant{}, err
+	}
+
+	// Validation
+	_, err = r.client.TokensClient.GetAgentTokenById(ctx, int(data.AgentTokenId.ValueInt64()))
+	if err != nil {
+		return clientSchema.ScopedPermissionGrant{}, err
+	}
+
+	if scope == clientSchema.PermissionDeploymentScopeDeployment {
+		_, err = r.client.DeploymentClient.GetDeploymentById(ctx, int(data.DeploymentId.ValueInt64()))
+		if err != nil {
+			return clientSchema.ScopedPermissionGrant{}, err
+		}
+	}
+
+	return r.client.TokensClient.CreateOrUpdateAgentTokenGrant(
+		ctx,
+		int(data.AgentTokenId.ValueInt64()),
+		scope,
+		int(data.DeploymentId.ValueInt64()),
+		grant,
+	)
+}
@@ -0,0 +1,120 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccResourceAgentTokenGrantConfig(grant string) string {
+	return testAccResourceAgentTokenGrantConfigWithKeepers(grant, "1")
+}
+
+func testAccResourceAgentTokenGrantConfigWithKeepers(grant string, version string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+data "dagster_current_deployment" "current" {}
+
+resource "dagster_agent_token" "test" {
+  description = "tf-acc-agent-token-grant"
+
+  keepers = {
+    version = "%s"
+  }
+
+  previous_token_grace_days = 7
+}
+
+resource "dagster_agent_token_grant" "test" {
+  agent_token_id = dagster_agent_token.test.id
+  deployment_id  = data.dagster_current_deployment.current.id
+  grant          = "%s"
+}
+
+resource "dagster_agent_token_grant" "branch_deployments" {
+  agent_token_id   = dagster_agent_token.test.id
+  deployment_scope = "ALL_BRANCH_DEPLOYMENTS"
+  grant            = "AGENT"
+}
+`, version, grant)
+}
+
+func TestAccResource_agentTokenGrant_basic(t *testing.T) {
+	var tokenId, deploymentId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceAgentTokenGrantConfig("OWNER"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: testAccResourceAgentTokenGrantConfig("AGENT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_agent_token_grant.test", "grant", "AGENT"),
+					resource.TestCheckResourceAttr("dagster_agent_token_grant.test", "deployment_scope", "DEPLOYMENT"),
+					resource.TestCheckResourceAttrSet("dagster_agent_token_grant.test", "id"),
+					resource.TestCheckResourceAttr("dagster_agent_token_grant.branch_deployments", "deployment_scope", "ALL_BRANCH_DEPLOYMENTS"),
+					testutils.FetchValueFromState("dagster_agent_token.test", "id", &tokenId),
+					testutils.FetchValueFromState("data.dagster_current_deployment.current", "id", &deploymentId),
+				),
+			},
+			{
+				Config: testAccResourceAgentTokenGrantConfig("VIEWER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_agent_token_grant.test", "grant", "VIEWER"),
+				),
+			},
+			{
+				ResourceName:      "dagster_agent_token_grant.test",
+				ImportState:       true,
+				ImportStateIdFunc: func(_ *terraform.State) (string, error) { return tokenId + "/" + deploymentId, nil },
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "dagster_agent_token_grant.branch_deployments",
+				ImportState:       true,
+				ImportStateIdFunc: func(_ *terraform.State) (string, error) { return tokenId + "/ALL_BRANCH_DEPLOYMENTS", nil },
+				ImportStateVerify: true,
+			},
+			// Rotating the token moves the grant to the new token and keeps it on the previous token
+			{
+				Config: testAccResourceAgentTokenGrantConfigWithKeepers("VIEWER", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("dagster_agent_token.test", "previous_token_id", &tokenId),
+					resource.TestCheckResourceAttrPair("dagster_agent_token_grant.test", "agent_token_id", "dagster_agent_token.test", "id"),
+					resource.TestCheckResourceAttr("dagster_agent_token_grant.test", "grant", "VIEWER"),
+					testAgentTokenGrantExists(&tokenId, &deploymentId),
+				),
+			},
+		},
+	})
+}
+
+// testAgentTokenGrantExists checks that an agent token still has its grant on a deployment
+func testAgentTokenGrantExists(tokenId *string, deploymentId *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		tokenIdInt, err := strconv.Atoi(*tokenId)
+		if err != nil {
+			return err
+		}
+
+		deploymentIdInt, err := strconv.Atoi(*deploymentId)
+		if err != nil {
+			return err
+		}
+
+		client := testutils.GetDagsterClientFromEnvVars()
+		_, err = client.TokensClient.GetAgentTokenGrant(context.Background(), tokenIdInt, clientSchema.PermissionDeploymentScopeDeployment, deploymentIdInt)
+		return err
+	}
+}
@@ -0,0 +1,113 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccResourceAgentTokenConfig(description string, keeper string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_agent_token" "test" {
+  description   = "%s"
+  rotation_days = 30
+
+  keepers = {
+    version = "%s"
+  }
+}
+`, description, keeper)
+}
+
+func TestAccResource_agentToken_basic(t *testing.T) {
+	var idBefore, idAfter string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAgentTokenConfig("tf-acc-agent-token", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_agent_token.test", "description", "tf-acc-agent-token"),
+					resource.TestCheckResourceAttrSet("dagster_agent_token.test", "token"),
+					testutils.FetchValueFromState("dagster_agent_token.test", "id", &idBefore),
+				),
+			},
+			// Changing the description keeps the token
+			{
+				Config: testAccResourceAgentTokenConfig("tf-acc-agent-token-updated", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_agent_token.test", "description", "tf-acc-agent-token-updated"),
+					resource.TestCheckResourceAttrPtr("dagster_agent_token.test", "id", &idBefore),
+				),
+			},
+			// Changing the keepers rotates the token, the old token stays valid as previous token
+			{
+				Config: testAccResourceAgentTokenConfig("tf-acc-agent-token-updated", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.FetchValueFromState("dagster_agent_token.test", "id", &idAfter),
+					func(_ *terraform.State) error {
+						if idBefore == idAfter {
+							return fmt.Errorf("expected agent token %s to be rotated", idBefore)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttrPtr("dagster_agent_token.test", "previous_token_id", &idBefore),
+					resource.TestCheckResourceAttrSet("dagster_agent_token.test", "previous_token"),
+					testAgentTokenExists(&idBefore),
+				),
+			},
+			{
+				ResourceName:            "dagster_agent_token.test",
+				ImportState:             true,
+				ImportStateIdFunc:       func(_ *terraform.State) (string, error) { return idAfter, nil },
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_days", "keepers", "previous_token_id", "previous_token"},
+			},
+			// An imported token adopts the configured keepers without a rotation
+			{
+				// The import drops the previous token from state
+				PreConfig: func() {
+					client := testutils.GetDagsterClientFromEnvVars()
+					tokenId, _ := strconv.Atoi(idBefore)
+					_ = client.TokensClient.RevokeAgentToken(context.Background(), tokenId)
+				},
+				ResourceName:       "dagster_agent_token.test",
+				ImportState:        true,
+				ImportStateIdFunc:  func(_ *terraform.State) (string, error) { return idAfter, nil },
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccResourceAgentTokenConfig("tf-acc-agent-token-updated", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("dagster_agent_token.test", "id", &idAfter),
+					resource.TestCheckResourceAttr("dagster_agent_token.test", "keepers.version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAgentTokenExists(id *string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := testutils.GetDagsterClientFromEnvVars()
+
+		tokenId, err := strconv.Atoi(*id)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.TokensClient.GetAgentTokenById(context.Background(), tokenId)
+		if err != nil {
+			return fmt.Errorf("expected agent token %s to still exist, got error: %w", *id, err)
+		}
+
+		return nil
+	}
+}
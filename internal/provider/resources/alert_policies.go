@@ -0,0 +1,247 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/service"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &AlertPoliciesResource{}
+	_ resource.ResourceWithModifyPlan = &AlertPoliciesResource{}
+)
+
+func NewAlertPoliciesResource() resource.Resource {
+	return &AlertPoliciesResource{}
+}
+
+type AlertPoliciesResource struct {
+	client client.DagsterClient
+}
+
+type AlertPoliciesResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Document      types.String `tfsdk:"document"`
+	AlertPolicies types.Map    `tfsdk:"alert_policies"`
+}
+
+func (r *AlertPoliciesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_policies"
+}
+
+func (r *AlertPoliciesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages all alert policies in the deployment the provider is configured with, like `dagster-cloud deployment alert-policies sync`. " +
+			"Alert policies that are not part of `document` are removed from the deployment. Do not combine this resource with `dagster_alert_policy` resources in the same deployment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the deployment the alert policies are reconciled in",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"document": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "All alert policies as a JSON document in the `alert_policies: [...]` format. " +
+					"We recommend using a `dagster_configuration_document` to generate this instead of composing a JSON document yourself.",
+			},
+			"alert_policies": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				MarkdownDescription: "Map of alert policy name to the alert policy as a normalized JSON document. " +
+					"Shows which alert policies are created, changed or deleted in the plan. PagerDuty integration keys are redacted.",
+			},
+		},
+	}
+}
+
+func (r *AlertPoliciesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan validates the document and computes the alert policies, so that the plan shows changes per alert policy
+func (r *AlertPoliciesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AlertPoliciesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Document.IsUnknown() {
+		plan.AlertPolicies = types.MapUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	alertPolicies, err := service.GetAlertPoliciesFromDocument(json.RawMessage(plan.Document.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("document"), "Invalid alert policies document", err.Error())
+		return
+	}
+
+	alertPoliciesMap, diags := alertPoliciesToMap(ctx, alertPolicies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.AlertPolicies = alertPoliciesMap
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *AlertPoliciesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AlertPoliciesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, err := r.client.AlertPoliciesClient.ReconcileAlertPoliciesFromDocument(ctx, json.RawMessage(data.Document.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reconcile alert policies, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("reconciled %d alert policies", len(names)))
+
+	data.Id = types.StringValue(r.client.Deployment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertPoliciesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AlertPoliciesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertPolicies, err := r.client.AlertPoliciesClient.ListAlertPolicies(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert policies, got error: %s", err))
+		return
+	}
+
+	// Alert policies created outside of Terraform show up as deletions in the plan
+	alertPoliciesMap, diags := alertPoliciesToMap(ctx, alertPolicies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(r.client.Deployment)
+	data.AlertPolicies = alertPoliciesMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertPoliciesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AlertPoliciesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, err := r.client.AlertPoliciesClient.ReconcileAlertPoliciesFromDocument(ctx, json.RawMessage(data.Document.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reconcile alert policies, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("reconciled %d alert policies", len(names)))
+
+	data.Id = types.StringValue(r.client.Deployment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertPoliciesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AlertPoliciesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.AlertPoliciesClient.ReconcileAlertPoliciesFromDocument(ctx, json.RawMessage(`{"alert_policies": []}`))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert policies, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted all alert policies of deployment %s", data.Id.ValueString()))
+}
+
+// redactedValue replaces secrets in the normalized alert policy documents
+const redactedValue = "(redacted)"
+
+// alertPoliciesToMap maps the name of every alert policy to its normalized JSON document, with secrets redacted
+func alertPoliciesToMap(ctx context.Context, alertPolicies []clientTypes.AlertPolicy) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	documents := make(map[string]string, len(alertPolicies))
+	for _, alertPolicy := range alertPolicies {
+		alertPolicy = normalizeAlertPolicy(alertPolicy)
+
+		// The integration key is a secret, keep it out of the plan output
+		if alertPolicy.NotificationService.PagerDuty != nil {
+			alertPolicy.NotificationService.PagerDuty = &clientTypes.AlertPolicyPagerDutyNotification{
+				IntegrationKey: redactedValue,
+			}
+		}
+
+		document, err := json.Marshal(alertPolicy)
+		if err != nil {
+			diags.AddError("JSON Format error", fmt.Sprintf("Unable to marshal alert policy %s: %s", alertPolicy.Name, err))
+			return types.MapNull(types.StringType), diags
+		}
+		documents[alertPolicy.Name] = string(document)
+	}
+
+	alertPoliciesMap, mapDiags := types.MapValueFrom(ctx, types.StringType, documents)
+	diags.Append(mapDiags...)
+
+	return alertPoliciesMap, diags
+}
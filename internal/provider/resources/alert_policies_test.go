@@ -0,0 +1,65 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccResourceAlertPoliciesConfig(names ...string) string {
+	policies := ""
+	for _, name := range names {
+		policies += fmt.Sprintf(`
+  - name: "%s"
+    description: "Alert policy created by acceptance tests"
+    event_types:
+      - "JOB_FAILURE"
+    notification_service:
+      email:
+        email_addresses:
+          - "test@example.com"`, name)
+	}
+
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_alert_policies" "test" {
+  document = data.dagster_configuration_document.test.json
+}
+
+data "dagster_configuration_document" "test" {
+  yaml_body = <<YAML
+alert_policies:%s
+YAML
+}
+`, policies)
+}
+
+func TestAccResource_alertPolicies_basic(t *testing.T) {
+	name := "tf-acc-alert-policies-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	otherName := "tf-acc-alert-policies-other-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAlertPoliciesConfig(name, otherName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_alert_policies.test", "alert_policies.%", "2"),
+					resource.TestCheckResourceAttrSet("dagster_alert_policies.test", "alert_policies."+name),
+					resource.TestCheckResourceAttrSet("dagster_alert_policies.test", "alert_policies."+otherName),
+				),
+			},
+			// Removing an alert policy from the document deletes it
+			{
+				Config: testAccResourceAlertPoliciesConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_alert_policies.test", "alert_policies.%", "1"),
+					resource.TestCheckNoResourceAttr("dagster_alert_policies.test", "alert_policies."+otherName),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,696 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	"github.com/datarootsio/terraform-provider-dagster/internal/client/service"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &AlertPolicyResource{}
+	_ resource.ResourceWithImportState      = &AlertPolicyResource{}
+	_ resource.ResourceWithModifyPlan       = &AlertPolicyResource{}
+	_ resource.ResourceWithConfigValidators = &AlertPolicyResource{}
+	_ resource.ResourceWithValidateConfig   = &AlertPolicyResource{}
+)
+
+// Repository name dagster uses for code locations that don't define an explicit repository
+const defaultAlertPolicyRepoName = "__repository__"
+
+func NewAlertPolicyResource() resource.Resource {
+	return &AlertPolicyResource{}
+}
+
+type AlertPolicyResource struct {
+	client client.DagsterClient
+}
+
+type AlertPolicyResourceModel struct {
+	Name                types.String `tfsdk:"name"`
+	Document            types.String `tfsdk:"document"`
+	Description         types.String `tfsdk:"description"`
+	EventTypes          types.Set    `tfsdk:"event_types"`
+	Tags                types.Map    `tfsdk:"tags"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	AlertTargets        types.List   `tfsdk:"alert_targets"`
+	NotificationService types.Object `tfsdk:"notification_service"`
+}
+
+type alertPolicyTargetModel struct {
+	AssetGroup *alertPolicyAssetGroupModel `tfsdk:"asset_group"`
+	AssetKey   []string                    `tfsdk:"asset_key"`
+}
+
+type alertPolicyAssetGroupModel struct {
+	AssetGroup   string       `tfsdk:"asset_group"`
+	LocationName string       `tfsdk:"location_name"`
+	RepoName     types.String `tfsdk:"repo_name"`
+}
+
+type alertPolicyNotificationServiceModel struct {
+	Email     *alertPolicyEmailModel     `tfsdk:"email"`
+	Slack     *alertPolicySlackModel     `tfsdk:"slack"`
+	PagerDuty *alertPolicyPagerDutyModel `tfsdk:"pagerduty"`
+}
+
+type alertPolicyEmailModel struct {
+	EmailAddresses []string `tfsdk:"email_addresses"`
+}
+
+type alertPolicySlackModel struct {
+	SlackWorkspaceName string `tfsdk:"slack_workspace_name"`
+	SlackChannelName   string `tfsdk:"slack_channel_name"`
+}
+
+type alertPolicyPagerDutyModel struct {
+	IntegrationKey string `tfsdk:"integration_key"`
+}
+
+var alertPolicyAssetGroupAttrTypes = map[string]attr.Type{
+	"asset_group":   types.StringType,
+	"location_name": types.StringType,
+	"repo_name":     types.StringType,
+}
+
+var alertPolicyTargetAttrTypes = map[string]attr.Type{
+	"asset_group": types.ObjectType{AttrTypes: alertPolicyAssetGroupAttrTypes},
+	"asset_key":   types.ListType{ElemType: types.StringType},
+}
+
+var alertPolicyNotificationServiceAttrTypes = map[string]attr.Type{
+	"email": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"email_addresses": types.ListType{ElemType: types.StringType},
+	}},
+	"slack": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"slack_workspace_name": types.StringType,
+		"slack_channel_name":   types.StringType,
+	}},
+	"pagerduty": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"integration_key": types.StringType,
+	}},
+}
+
+func (r *AlertPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_policy"
+}
+
+func (r *AlertPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates an alert policy in the deployment the provider is configured with. " +
+			"The alert policy is either defined as a JSON `document` or with the typed attributes (`event_types`, `notification_service`, ...).",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Alert policy name, the alert policy is recreated when it changes. Required when `document` is not set, taken from the document otherwise and conflicts with `document`.",
+			},
+			"document": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Alert policy as a JSON document. We recommend using a `dagster_configuration_document` to generate this instead of composing a JSON document yourself. " +
+					"Documents in the `alert_policies: [...]` format are accepted when they contain exactly one alert policy. Conflicts with `name` and the typed attributes.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("name"),
+						path.MatchRoot("description"),
+						path.MatchRoot("event_types"),
+						path.MatchRoot("tags"),
+						path.MatchRoot("enabled"),
+						path.MatchRoot("alert_targets"),
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Alert policy description",
+			},
+			"event_types": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Event types that trigger the alert policy, one or more of `%s`. Required when `document` is not set.", strings.Join(clientTypes.AlertPolicyEventTypeEnumValues(), "`, `")),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(clientTypes.AlertPolicyEventTypeEnumValues()...),
+					),
+				},
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Tags the jobs or assets must have to trigger the alert policy, use an empty value for tags without a value",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether the alert policy is enabled. Alert policies are enabled when not set.",
+			},
+			"alert_targets": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Assets the alert policy applies to, for asset event types",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"asset_group": schema.SingleNestedAttribute{
+							Optional:            true,
+							MarkdownDescription: "Target all assets of an asset group. Exactly one of `asset_group` or `asset_key` is required.",
+							Attributes: map[string]schema.Attribute{
+								"asset_group": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "Asset group name",
+								},
+								"location_name": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "Code location of the asset group",
+								},
+								"repo_name": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: fmt.Sprintf("Repository of the asset group. Dagster uses `%s` when not set.", defaultAlertPolicyRepoName),
+								},
+							},
+							Validators: []validator.Object{
+								objectvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("asset_key"),
+								),
+							},
+						},
+						"asset_key": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "Target a single asset by the path of its asset key. Exactly one of `asset_group` or `asset_key` is required.",
+						},
+					},
+				},
+			},
+			"notification_service": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Where the alerts are sent to. Required when `document` is not set.",
+				Attributes: map[string]schema.Attribute{
+					"email": schema.SingleNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Send alerts by email. Exactly one of `email`, `slack` or `pagerduty` is required.",
+						Attributes: map[string]schema.Attribute{
+							"email_addresses": schema.ListAttribute{
+								ElementType:         types.StringType,
+								Required:            true,
+								MarkdownDescription: "Email addresses the alerts are sent to",
+							},
+						},
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("slack"),
+								path.MatchRelative().AtParent().AtName("pagerduty"),
+							),
+						},
+					},
+					"slack": schema.SingleNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Send alerts to a Slack channel. Exactly one of `email`, `slack` or `pagerduty` is required.",
+						Attributes: map[string]schema.Attribute{
+							"slack_workspace_name": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Slack workspace name",
+							},
+							"slack_channel_name": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Slack channel name",
+							},
+						},
+					},
+					"pagerduty": schema.SingleNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Send alerts to PagerDuty. Exactly one of `email`, `slack` or `pagerduty` is required.",
+						Attributes: map[string]schema.Attribute{
+							"integration_key": schema.StringAttribute{
+								Required:            true,
+								Sensitive:           true,
+								MarkdownDescription: "PagerDuty integration key",
+							},
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(
+						path.MatchRoot("name"),
+						path.MatchRoot("event_types"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *AlertPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("document"),
+			path.MatchRoot("notification_service"),
+		),
+	}
+}
+
+// ValidateConfig validates the name and event types of the document at plan time
+func (r *AlertPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var document types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("document"), &document)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if document.IsNull() || document.IsUnknown() {
+		return
+	}
+
+	_, err := service.GetAlertPolicyFromDocument(json.RawMessage(document.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("document"),
+			"Invalid alert policy document",
+			fmt.Sprintf("Unable to parse alert policy document, got error: %s", err),
+		)
+	}
+}
+
+func (r *AlertPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan takes the name from the document and recreates the alert policy when its name changes,
+// alert policies are identified by their name
+func (r *AlertPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AlertPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Document.IsNull() {
+		if plan.Document.IsUnknown() {
+			plan.Name = types.StringUnknown()
+		} else {
+			alertPolicy, err := service.GetAlertPolicyFromDocument(json.RawMessage(plan.Document.ValueString()))
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("document"), "Invalid alert policy document", err.Error())
+				return
+			}
+			plan.Name = types.StringValue(alertPolicy.Name)
+		}
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+
+	// Resource is being created
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state AlertPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.IsUnknown() || plan.Name.ValueString() != state.Name.ValueString() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("name"))
+	}
+}
+
+func (r *AlertPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AlertPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document, diags := alertPolicyDocumentFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.AlertPoliciesClient.GetAlertPolicyByName(ctx, data.Name.ValueString())
+	if err == nil {
+		err = &clientTypes.ErrAlreadyExists{What: "AlertPolicy", Key: "name", Value: data.Name.ValueString()}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert policy, got error: %s", err))
+		return
+	}
+
+	err = r.client.AlertPoliciesClient.CreateOrUpdateAlertPolicyFromDocument(ctx, document)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert policy, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created alert policy with name: %s", data.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AlertPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertPolicyAsDocument, err := r.client.AlertPoliciesClient.GetAlertPolicyAsDocumentByName(ctx, data.Name.ValueString())
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Alert policy not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert policy, got error: %s", err))
+		}
+		return
+	}
+
+	alertPolicy, err := service.GetAlertPolicyFromDocument(alertPolicyAsDocument)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert policy, got error: %s", err))
+		return
+	}
+
+	if !data.Document.IsNull() {
+		// Only replace the document in the state when it differs semantically, to keep the formatting of the configuration
+		stateAlertPolicy, err := service.GetAlertPolicyFromDocument(json.RawMessage(data.Document.ValueString()))
+		if err != nil || !alertPoliciesAreEqual(stateAlertPolicy, alertPolicy) {
+			documentString, err := utils.MakeJSONStringUniform(alertPolicyAsDocument)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"JSON Format error",
+					fmt.Sprintf("Trying to parse JSON: %s: %s", alertPolicyAsDocument, err.Error()),
+				)
+				return
+			}
+			data.Document = types.StringValue(documentString)
+		}
+	} else {
+		resp.Diagnostics.Append(setAlertPolicyResourceModel(ctx, &data, alertPolicy)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AlertPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document, diags := alertPolicyDocumentFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.AlertPoliciesClient.GetAlertPolicyByName(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert policy, got error: %s", err))
+		return
+	}
+
+	err = r.client.AlertPoliciesClient.CreateOrUpdateAlertPolicyFromDocument(ctx, document)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert policy, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated alert policy resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AlertPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AlertPoliciesClient.DeleteAlertPolicy(ctx, data.Name.ValueString())
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Alert policy not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert policy, got error: %s", err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted alert policy with name: %s", data.Name.ValueString()))
+}
+
+func (r *AlertPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// alertPolicyDocumentFromModel returns the document to send to the API, either as configured or built from the typed attributes
+func alertPolicyDocumentFromModel(ctx context.Context, data AlertPolicyResourceModel) (json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.Document.IsNull() {
+		return json.RawMessage(data.Document.ValueString()), diags
+	}
+
+	alertPolicy := clientTypes.AlertPolicy{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Tags:        []clientTypes.AlertPolicyTag{},
+		EventTypes:  []string{},
+	}
+
+	diags.Append(data.EventTypes.ElementsAs(ctx, &alertPolicy.EventTypes, false)...)
+
+	tags := make(map[string]string, len(data.Tags.Elements()))
+	if !data.Tags.IsNull() {
+		diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+	for key, value := range tags {
+		alertPolicy.Tags = append(alertPolicy.Tags, clientTypes.AlertPolicyTag{Key: key, Value: value})
+	}
+	sort.Slice(alertPolicy.Tags, func(i, j int) bool { return alertPolicy.Tags[i].Key < alertPolicy.Tags[j].Key })
+
+	if !data.Enabled.IsNull() {
+		enabled := data.Enabled.ValueBool()
+		alertPolicy.Enabled = &enabled
+	}
+
+	var targets []alertPolicyTargetModel
+	if !data.AlertTargets.IsNull() {
+		diags.Append(data.AlertTargets.ElementsAs(ctx, &targets, false)...)
+	}
+	for _, target := range targets {
+		if target.AssetGroup != nil {
+			alertPolicy.AlertTargets = append(alertPolicy.AlertTargets, clientTypes.AlertPolicyTarget{
+				AssetGroupTarget: &clientTypes.AlertPolicyAssetGroupTarget{
+					AssetGroup:   target.AssetGroup.AssetGroup,
+					LocationName: target.AssetGroup.LocationName,
+					RepoName:     target.AssetGroup.RepoName.ValueString(),
+				},
+			})
+		} else {
+			alertPolicy.AlertTargets = append(alertPolicy.AlertTargets, clientTypes.AlertPolicyTarget{
+				AssetKeyTarget: &clientTypes.AlertPolicyAssetKeyTarget{AssetKey: target.AssetKey},
+			})
+		}
+	}
+
+	var notificationService alertPolicyNotificationServiceModel
+	diags.Append(data.NotificationService.As(ctx, &notificationService, basetypes.ObjectAsOptions{})...)
+	switch {
+	case notificationService.Email != nil:
+		alertPolicy.NotificationService.Email = &clientTypes.AlertPolicyEmailNotification{
+			EmailAddresses: notificationService.Email.EmailAddresses,
+		}
+	case notificationService.Slack != nil:
+		alertPolicy.NotificationService.Slack = &clientTypes.AlertPolicySlackNotification{
+			SlackWorkspaceName: notificationService.Slack.SlackWorkspaceName,
+			SlackChannelName:   notificationService.Slack.SlackChannelName,
+		}
+	case notificationService.PagerDuty != nil:
+		alertPolicy.NotificationService.PagerDuty = &clientTypes.AlertPolicyPagerDutyNotification{
+			IntegrationKey: notificationService.PagerDuty.IntegrationKey,
+		}
+	}
+
+	if diags.HasError() {
+		return json.RawMessage{}, diags
+	}
+
+	document, err := json.Marshal(alertPolicy)
+	if err != nil {
+		diags.AddError("JSON Format error", fmt.Sprintf("Unable to marshal alert policy: %s", err))
+	}
+
+	return document, diags
+}
+
+// setAlertPolicyResourceModel sets the typed attributes from the alert policy. Attributes that are not set
+// in the state and of which the alert policy has the default value are kept null.
+func setAlertPolicyResourceModel(ctx context.Context, data *AlertPolicyResourceModel, alertPolicy clientTypes.AlertPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Name = types.StringValue(alertPolicy.Name)
+
+	if alertPolicy.Description != "" || !data.Description.IsNull() {
+		data.Description = types.StringValue(alertPolicy.Description)
+	}
+
+	eventTypes, setDiags := types.SetValueFrom(ctx, types.StringType, alertPolicy.EventTypes)
+	diags.Append(setDiags...)
+	data.EventTypes = eventTypes
+
+	if len(alertPolicy.Tags) > 0 || !data.Tags.IsNull() {
+		tags := make(map[string]string, len(alertPolicy.Tags))
+		for _, tag := range alertPolicy.Tags {
+			tags[tag.Key] = tag.Value
+		}
+		tagsMap, mapDiags := types.MapValueFrom(ctx, types.StringType, tags)
+		diags.Append(mapDiags...)
+		data.Tags = tagsMap
+	}
+
+	enabled := alertPolicy.Enabled == nil || *alertPolicy.Enabled
+	if !enabled || !data.Enabled.IsNull() {
+		data.Enabled = types.BoolValue(enabled)
+	}
+
+	if len(alertPolicy.AlertTargets) > 0 || !data.AlertTargets.IsNull() {
+		var stateTargets []alertPolicyTargetModel
+		if !data.AlertTargets.IsNull() && !data.AlertTargets.IsUnknown() {
+			diags.Append(data.AlertTargets.ElementsAs(ctx, &stateTargets, false)...)
+		}
+
+		targets := make([]alertPolicyTargetModel, 0, len(alertPolicy.AlertTargets))
+		for i, target := range alertPolicy.AlertTargets {
+			switch {
+			case target.AssetGroupTarget != nil:
+				repoName := types.StringValue(target.AssetGroupTarget.RepoName)
+				repoNameUnset := i >= len(stateTargets) || stateTargets[i].AssetGroup == nil || stateTargets[i].AssetGroup.RepoName.IsNull()
+				if target.AssetGroupTarget.RepoName == "" || (repoNameUnset && target.AssetGroupTarget.RepoName == defaultAlertPolicyRepoName) {
+					repoName = types.StringNull()
+				}
+				targets = append(targets, alertPolicyTargetModel{
+					AssetGroup: &alertPolicyAssetGroupModel{
+						AssetGroup:   target.AssetGroupTarget.AssetGroup,
+						LocationName: target.AssetGroupTarget.LocationName,
+						RepoName:     repoName,
+					},
+				})
+			case target.AssetKeyTarget != nil:
+				targets = append(targets, alertPolicyTargetModel{AssetKey: target.AssetKeyTarget.AssetKey})
+			}
+		}
+
+		alertTargets, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: alertPolicyTargetAttrTypes}, targets)
+		diags.Append(listDiags...)
+		data.AlertTargets = alertTargets
+	}
+
+	notificationService := alertPolicyNotificationServiceModel{}
+	switch {
+	case alertPolicy.NotificationService.Email != nil:
+		notificationService.Email = &alertPolicyEmailModel{EmailAddresses: alertPolicy.NotificationService.Email.EmailAddresses}
+	case alertPolicy.NotificationService.Slack != nil:
+		notificationService.Slack = &alertPolicySlackModel{
+			SlackWorkspaceName: alertPolicy.NotificationService.Slack.SlackWorkspaceName,
+			SlackChannelName:   alertPolicy.NotificationService.Slack.SlackChannelName,
+		}
+	case alertPolicy.NotificationService.PagerDuty != nil:
+		notificationService.PagerDuty = &alertPolicyPagerDutyModel{IntegrationKey: alertPolicy.NotificationService.PagerDuty.IntegrationKey}
+	}
+
+	notificationServiceObject, objectDiags := types.ObjectValueFrom(ctx, alertPolicyNotificationServiceAttrTypes, notificationService)
+	diags.Append(objectDiags...)
+	data.NotificationService = notificationServiceObject
+
+	return diags
+}
+
+// alertPoliciesAreEqual compares two alert policies, ignoring the order of event types and tags and the default values
+func alertPoliciesAreEqual(a clientTypes.AlertPolicy, b clientTypes.AlertPolicy) bool {
+	return reflect.DeepEqual(normalizeAlertPolicy(a), normalizeAlertPolicy(b))
+}
+
+func normalizeAlertPolicy(alertPolicy clientTypes.AlertPolicy) clientTypes.AlertPolicy {
+	enabled := alertPolicy.Enabled == nil || *alertPolicy.Enabled
+	alertPolicy.Enabled = &enabled
+
+	eventTypes := append([]string{}, alertPolicy.EventTypes...)
+	sort.Strings(eventTypes)
+	alertPolicy.EventTypes = eventTypes
+
+	tags := append([]clientTypes.AlertPolicyTag{}, alertPolicy.Tags...)
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+	alertPolicy.Tags = tags
+
+	alertTargets := make([]clientTypes.AlertPolicyTarget, 0, len(alertPolicy.AlertTargets))
+	for _, target := range alertPolicy.AlertTargets {
+		if target.AssetGroupTarget != nil && target.AssetGroupTarget.RepoName == "" {
+			assetGroupTarget := *target.AssetGroupTarget
+			assetGroupTarget.RepoName = defaultAlertPolicyRepoName
+			target.AssetGroupTarget = &assetGroupTarget
+		}
+		alertTargets = append(alertTargets, target)
+	}
+	alertPolicy.AlertTargets = alertTargets
+
+	return alertPolicy
+}
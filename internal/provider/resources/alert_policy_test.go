@@ -0,0 +1,136 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccResourceAlertPolicyConfig(name string, eventType string, enabled bool) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_alert_policy" "test" {
+  name        = "%s"
+  description = "Alert policy created by acceptance tests"
+  event_types = ["%s"]
+  enabled     = %t
+
+  tags = {
+    team = "data"
+  }
+
+  notification_service = {
+    email = {
+      email_addresses = ["test@example.com"]
+    }
+  }
+}
+`, name, eventType, enabled)
+}
+
+func testAccResourceAlertPolicyFromDocumentConfig(name string, eventType string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_alert_policy" "test" {
+  document = data.dagster_configuration_document.test.json
+}
+
+data "dagster_configuration_document" "test" {
+  yaml_body = <<YAML
+alert_policies:
+  - name: "%s"
+    description: "Alert policy created by acceptance tests"
+    event_types:
+      - "%s"
+    notification_service:
+      email:
+        email_addresses:
+          - "test@example.com"
+YAML
+}
+`, name, eventType)
+}
+
+func TestAccResource_alertPolicy_basic(t *testing.T) {
+	name := "tf-acc-alert-policy-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid event types are rejected at plan time
+			{
+				Config:      testAccResourceAlertPolicyConfig(name, "NOT_AN_EVENT", true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: testAccResourceAlertPolicyConfig(name, "JOB_FAILURE", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_alert_policy.test", "name", name),
+					resource.TestCheckResourceAttr("dagster_alert_policy.test", "event_types.#", "1"),
+					resource.TestCheckResourceAttr("dagster_alert_policy.test", "tags.team", "data"),
+					resource.TestCheckResourceAttr("dagster_alert_policy.test", "notification_service.email.email_addresses.0", "test@example.com"),
+				),
+			},
+			{
+				Config: testAccResourceAlertPolicyConfig(name, "TICK_FAILURE", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("dagster_alert_policy.test", "event_types.*", "TICK_FAILURE"),
+					resource.TestCheckResourceAttr("dagster_alert_policy.test", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:                         "dagster_alert_policy.test",
+				ImportState:                          true,
+				ImportStateId:                        name,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccResource_alertPolicy_fromDocument(t *testing.T) {
+	name := "tf-acc-alert-policy-doc-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid event types in the document are rejected at plan time
+			{
+				Config:      testAccResourceAlertPolicyFromDocumentConfig(name, "NOT_AN_EVENT"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`event type NOT_AN_EVENT of alert policy`),
+			},
+			// The name is taken from the document and can't be set as well
+			{
+				Config: strings.Replace(
+					testAccResourceAlertPolicyFromDocumentConfig(name, "JOB_FAILURE"),
+					"document = data.dagster_configuration_document.test.json",
+					"document = data.dagster_configuration_document.test.json\n  name     = \"other-name\"",
+					1,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccResourceAlertPolicyFromDocumentConfig(name, "JOB_FAILURE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_alert_policy.test", "name", name),
+					resource.TestCheckNoResourceAttr("dagster_alert_policy.test", "event_types"),
+				),
+			},
+			{
+				Config: testAccResourceAlertPolicyFromDocumentConfig(name, "JOB_SUCCESS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_alert_policy.test", "name", name),
+				),
+			},
+		},
+	})
+}
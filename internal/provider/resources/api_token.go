@@ -0,0 +1,259 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ApiTokenResource{}
+	_ resource.ResourceWithImportState = &ApiTokenResource{}
+)
+
+func NewApiTokenResource() resource.Resource {
+	return &ApiTokenResource{}
+}
+
+type ApiTokenResource struct {
+	client client.DagsterClient
+}
+
+type ApiTokenResourceModel struct {
+	Id              types.String  `tfsdk:"id"`
+	TokenType       types.String  `tfsdk:"token_type"`
+	Description     types.String  `tfsdk:"description"`
+	Token           types.String  `tfsdk:"token"`
+	CreateTimestamp types.Float64 `tfsdk:"create_timestamp"`
+}
+
+func (r *ApiTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *ApiTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates an organization API token for an integration, like the SCIM token used to provision users and teams from an identity provider.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "API token id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(clientSchema.DagsterCloudApiTokenTypeScim)),
+				MarkdownDescription: "Type of the API token, one of `" + strings.Join(clientTypes.ApiTokenTypeEnumValues(), "`, `") + "`. DEFAULT `SCIM`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.ApiTokenTypeEnumValues()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "API token description. DEFAULT `\"\"`",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_timestamp": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the creation of the token",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApiTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenType, err := clientTypes.ConvertToApiTokenTypeEnum(data.TokenType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API token, got error: %s", err))
+		return
+	}
+
+	token, err := r.client.TokensClient.CreateApiToken(ctx, tokenType, data.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API token, got error: %s", err))
+		return
+	}
+
+	setApiTokenResourceModel(&data, token)
+
+	tflog.Trace(ctx, fmt.Sprintf("created API token with id: %s", token.Id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenType, err := clientTypes.ConvertToApiTokenTypeEnum(data.TokenType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token, got error: %s", err))
+		return
+	}
+
+	token, err := r.client.TokensClient.GetApiTokenById(ctx, tokenType, data.Id.ValueString())
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "API token not found, probably already revoked manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token, got error: %s", err))
+		}
+		return
+	}
+
+	setApiTokenResourceModel(&data, token)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenType, err := clientTypes.ConvertToApiTokenTypeEnum(data.TokenType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update API token, got error: %s", err))
+		return
+	}
+
+	token, err := r.client.TokensClient.EditApiTokenDescription(ctx, tokenType, data.Id.ValueString(), data.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update API token, got error: %s", err))
+		return
+	}
+
+	setApiTokenResourceModel(&data, token)
+
+	tflog.Trace(ctx, "updated API token resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenType, err := clientTypes.ConvertToApiTokenTypeEnum(data.TokenType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke API token, got error: %s", err))
+		return
+	}
+
+	err = r.client.TokensClient.RevokeApiToken(ctx, tokenType, data.Id.ValueString())
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "API token not found, probably already revoked manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke API token, got error: %s", err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("revoked API token with id: %s", data.Id.ValueString()))
+}
+
+// ImportState imports an API token by `token_type/id`
+func (r *ApiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tokenTypeString, id, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		resp.Diagnostics.AddError("Invalid import id", fmt.Sprintf("Expected an import id like token_type/id, got: %s", req.ID))
+		return
+	}
+
+	tokenType, err := clientTypes.ConvertToApiTokenTypeEnum(tokenTypeString)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", fmt.Sprintf("Unable to import API token, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("token_type"), string(tokenType))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func setApiTokenResourceModel(data *ApiTokenResourceModel, token clientSchema.ApiToken) {
+	data.Id = types.StringValue(token.Id)
+	data.TokenType = types.StringValue(string(token.TokenType))
+	data.Description = types.StringValue(token.Description)
+	data.Token = types.StringValue(token.Token)
+	data.CreateTimestamp = types.Float64Value(token.CreateTimestamp)
+}
@@ -0,0 +1,59 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccResourceApiTokenConfig(tokenType string, description string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_api_token" "test" {
+  token_type  = "%s"
+  description = "%s"
+}
+`, tokenType, description)
+}
+
+func TestAccResource_apiToken_basic(t *testing.T) {
+	var tokenId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceApiTokenConfig("NOT_A_TYPE", "tf-acc-api-token"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: testAccResourceApiTokenConfig("SCIM", "tf-acc-api-token"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_api_token.test", "token_type", "SCIM"),
+					resource.TestCheckResourceAttr("dagster_api_token.test", "description", "tf-acc-api-token"),
+					resource.TestCheckResourceAttrSet("dagster_api_token.test", "token"),
+					testutils.FetchValueFromState("dagster_api_token.test", "id", &tokenId),
+				),
+			},
+			// Changing the description keeps the token
+			{
+				Config: testAccResourceApiTokenConfig("SCIM", "tf-acc-api-token-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_api_token.test", "description", "tf-acc-api-token-updated"),
+					resource.TestCheckResourceAttrPtr("dagster_api_token.test", "id", &tokenId),
+				),
+			},
+			{
+				ResourceName:      "dagster_api_token.test",
+				ImportState:       true,
+				ImportStateIdFunc: func(_ *terraform.State) (string, error) { return "SCIM/" + tokenId, nil },
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
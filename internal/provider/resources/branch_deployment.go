@@ -0,0 +1,402 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &BranchDeploymentResource{}
+
+func NewBranchDeploymentResource() resource.Resource {
+	return &BranchDeploymentResource{}
+}
+
+type BranchDeploymentResource struct {
+	client client.DagsterClient
+}
+
+type BranchDeploymentResourceModel struct {
+	Id                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Status            types.String `tfsdk:"status"`
+	RepoName          types.String `tfsdk:"repo_name"`
+	BranchName        types.String `tfsdk:"branch_name"`
+	BranchUrl         types.String `tfsdk:"branch_url"`
+	PullRequestUrl    types.String `tfsdk:"pull_request_url"`
+	PullRequestNumber types.String `tfsdk:"pull_request_number"`
+	PullRequestStatus types.String `tfsdk:"pull_request_status"`
+	Commit            types.Object `tfsdk:"commit"`
+}
+
+var branchDeploymentCommitAttributeTypes = map[string]attr.Type{
+	"hash":              types.StringType,
+	"message":           types.StringType,
+	"url":               types.StringType,
+	"author_name":       types.StringType,
+	"author_email":      types.StringType,
+	"author_avatar_url": types.StringType,
+	"timestamp":         types.Float64Type,
+}
+
+func (r *BranchDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_deployment"
+}
+
+func (r *BranchDeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a branch deployment for a branch of a repository, like `dagster-cloud branch-deployment create-or-update`. " +
+			"New commits update the branch deployment in place. On destroy, the pull request status is set to `CLOSED` and the branch deployment is deleted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Branch deployment id",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Branch deployment name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Branch deployment status (`ACTIVE` or `PENDING_DELETION`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repo_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the repository, for example `org/repo`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the branch",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "URL of the branch",
+			},
+			"pull_request_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "URL of the pull request",
+			},
+			"pull_request_number": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Number of the pull request",
+			},
+			"pull_request_status": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(clientSchema.PullRequestStatusOpen)),
+				MarkdownDescription: "Status of the pull request, one of `" + strings.Join(clientTypes.PullRequestStatusEnumValues(), "`, `") + "`. DEFAULT `OPEN`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.PullRequestStatusEnumValues()...),
+				},
+			},
+			"commit": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Latest commit of the branch, changing it updates the branch deployment in place",
+				Attributes: map[string]schema.Attribute{
+					"hash": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Commit hash",
+					},
+					"message": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Commit message",
+					},
+					"url": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Commit URL",
+					},
+					"author_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Name of the commit author",
+					},
+					"author_email": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Email address of the commit author",
+					},
+					"author_avatar_url": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Avatar URL of the commit author",
+					},
+					"timestamp": schema.Float64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Unix timestamp of the commit, defaults to the time of the apply",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *BranchDeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BranchDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BranchDeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.createOrUpdateBranchDeployment(ctx, data, data.PullRequestStatus.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create branch deployment, got error: %s", err))
+		return
+	}
+
+	setBranchDeploymentComputedAttributes(&data, deployment)
+
+	tflog.Trace(ctx, fmt.Sprintf("created branch deployment %s with id: %d", deployment.DeploymentName, deployment.DeploymentId))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BranchDeploymentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.getBranchDeployment(ctx, data)
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Branch deployment not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch deployment, got error: %s", err))
+		}
+		return
+	}
+
+	gitMetadata := deployment.BranchDeploymentGitMetadata
+	data.RepoName = types.StringValue(gitMetadata.RepoName)
+	data.BranchName = types.StringValue(gitMetadata.BranchName)
+	data.BranchUrl = stringValueOrNull(gitMetadata.BranchUrl)
+	data.PullRequestUrl = stringValueOrNull(gitMetadata.PullRequestUrl)
+	data.PullRequestNumber = stringValueOrNull(gitMetadata.PullRequestNumber)
+	if gitMetadata.PullRequestStatus != "" {
+		data.PullRequestStatus = types.StringValue(string(gitMetadata.PullRequestStatus))
+	}
+
+	// The timestamp is kept as configured, Dagster Cloud fills it in when it's not given
+	timestamp := types.Float64Null()
+	if timestampState, ok := data.Commit.Attributes()["timestamp"].(types.Float64); ok {
+		timestamp = timestampState
+	}
+
+	latestCommit := deployment.LatestCommit
+	commit, diags := types.ObjectValue(branchDeploymentCommitAttributeTypes, map[string]attr.Value{
+		"hash":              types.StringValue(latestCommit.CommitHash),
+		"message":           stringValueOrNull(latestCommit.CommitMessage),
+		"url":               stringValueOrNull(latestCommit.CommitUrl),
+		"author_name":       stringValueOrNull(latestCommit.AuthorName),
+		"author_email":      stringValueOrNull(latestCommit.AuthorEmail),
+		"author_avatar_url": stringValueOrNull(latestCommit.AuthorAvatarUrl),
+		"timestamp":         timestamp,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Commit = commit
+	setBranchDeploymentComputedAttributes(&data, deployment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BranchDeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.createOrUpdateBranchDeployment(ctx, data, data.PullRequestStatus.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update branch deployment, got error: %s", err))
+		return
+	}
+
+	setBranchDeploymentComputedAttributes(&data, deployment)
+
+	tflog.Trace(ctx, "updated branch deployment resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BranchDeploymentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Closing creates the branch deployment again when it was already deleted
+	_, err := r.getBranchDeployment(ctx, data)
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Branch deployment not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch deployment, got error: %s", err))
+		}
+		return
+	}
+
+	// Close the pull request first, like the dagster-cloud CLI does when a pull request is closed
+	_, err = r.createOrUpdateBranchDeployment(ctx, data, string(clientSchema.PullRequestStatusClosed))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to close branch deployment, got error: %s", err))
+		return
+	}
+
+	err = r.client.DeploymentClient.DeleteDeployment(ctx, int(data.Id.ValueInt64()))
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Branch deployment not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch deployment, got error: %s", err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted branch deployment %s with id: %d", data.Name.ValueString(), data.Id.ValueInt64()))
+}
+
+// getBranchDeployment looks up the branch deployment of the resource by name, a branch deployment with the same name
+// but another id was created after this one was deleted and is not found
+func (r *BranchDeploymentResource) getBranchDeployment(ctx context.Context, data BranchDeploymentResourceModel) (clientSchema.BranchDeployment, error) {
+	deployment, err := r.client.DeploymentClient.GetBranchDeploymentByName(ctx, data.Name.ValueString())
+	if err != nil {
+		return clientSchema.BranchDeployment{}, err
+	}
+
+	if deployment.DeploymentId != int(data.Id.ValueInt64()) {
+		return clientSchema.BranchDeployment{}, &clientTypes.ErrNotFound{What: "branch deployment", Key: "id", Value: strconv.FormatInt(data.Id.ValueInt64(), 10)}
+	}
+
+	return deployment, nil
+}
+
+func (r *BranchDeploymentResource) createOrUpdateBranchDeployment(ctx context.Context, data BranchDeploymentResourceModel, pullRequestStatus string) (clientSchema.BranchDeployment, error) {
+	status, err := clientTypes.ConvertToPullRequestStatusEnum(pullRequestStatus)
+	if err != nil {
+		return clientSchema.BranchDeployment{}, err
+	}
+
+	commit, diags := branchDeploymentCommitInput(data.Commit)
+	if diags.HasError() {
+		return clientSchema.BranchDeployment{}, fmt.Errorf("invalid commit: %v", diags)
+	}
+
+	return r.client.DeploymentClient.CreateOrUpdateBranchDeployment(
+		ctx,
+		clientSchema.CreateOrUpdateBranchDeploymentInput{
+			RepoName:          data.RepoName.ValueString(),
+			BranchName:        data.BranchName.ValueString(),
+			BranchUrl:         data.BranchUrl.ValueString(),
+			PullRequestUrl:    data.PullRequestUrl.ValueString(),
+			PullRequestStatus: status,
+			PullRequestNumber: data.PullRequestNumber.ValueString(),
+		},
+		commit,
+	)
+}
+
+func branchDeploymentCommitInput(commit types.Object) (clientSchema.DeploymentCommitInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := commit.Attributes()
+	stringAttribute := func(name string) string {
+		value, ok := attributes[name].(types.String)
+		if !ok {
+			diags.AddError("Invalid commit", fmt.Sprintf("Unexpected type of commit attribute %s", name))
+			return ""
+		}
+		return value.ValueString()
+	}
+
+	timestamp := float64(time.Now().Unix())
+	if timestampValue, ok := attributes["timestamp"].(types.Float64); ok && !timestampValue.IsNull() {
+		timestamp = timestampValue.ValueFloat64()
+	}
+
+	return clientSchema.DeploymentCommitInput{
+		CommitHash:      stringAttribute("hash"),
+		Timestamp:       timestamp,
+		CommitMessage:   stringAttribute("message"),
+		CommitUrl:       stringAttribute("url"),
+		AuthorName:      stringAttribute("author_name"),
+		AuthorEmail:     stringAttribute("author_email"),
+		AuthorAvatarUrl: stringAttribute("author_avatar_url"),
+	}, diags
+}
+
+func setBranchDeploymentComputedAttributes(data *BranchDeploymentResourceModel, deployment clientSchema.BranchDeployment) {
+	data.Id = types.Int64Value(int64(deployment.DeploymentId))
+	data.Name = types.StringValue(deployment.DeploymentName)
+	data.Status = types.StringValue(string(deployment.DeploymentStatus))
+}
@@ -0,0 +1,57 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccResourceBranchDeploymentConfig(branchName string, commitHash string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_branch_deployment" "test" {
+  repo_name           = "datarootsio/terraform-provider-dagster"
+  branch_name         = "%s"
+  pull_request_number = "1"
+
+  commit = {
+    hash        = "%s"
+    message     = "Commit created by acceptance tests"
+    author_name = "Terraform"
+    timestamp   = 1700000000
+  }
+}
+`, branchName, commitHash)
+}
+
+func TestAccResourceBranchDeployment(t *testing.T) {
+	branchName := "tf-acc-branch-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBranchDeploymentConfig(branchName, "0000000000000000000000000000000000000001"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_branch_deployment.test", "branch_name", branchName),
+					resource.TestCheckResourceAttr("dagster_branch_deployment.test", "pull_request_status", "OPEN"),
+					resource.TestCheckResourceAttr("dagster_branch_deployment.test", "commit.hash", "0000000000000000000000000000000000000001"),
+					resource.TestCheckResourceAttrSet("dagster_branch_deployment.test", "name"),
+					testutils.FetchValueFromState("dagster_branch_deployment.test", "id", &id),
+				),
+			},
+			// A new commit updates the branch deployment in place
+			{
+				Config: testAccResourceBranchDeploymentConfig(branchName, "0000000000000000000000000000000000000002"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_branch_deployment.test", "commit.hash", "0000000000000000000000000000000000000002"),
+					resource.TestCheckResourceAttrPtr("dagster_branch_deployment.test", "id", &id),
+				),
+			},
+		},
+	})
+}
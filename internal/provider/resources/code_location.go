@@ -19,7 +19,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.Resource = &CodeLocationResource{}
+var (
+	_ resource.Resource                = &CodeLocationResource{}
+	_ resource.ResourceWithImportState = &CodeLocationResource{}
+)
 
 func NewCodeLocationResource() resource.Resource {
 	return &CodeLocationResource{}
@@ -367,3 +370,8 @@ func objectValueOrNull(attributeTypes map[string]attr.Type, attributeValues map[
 
 	return types.ObjectNull(attributeTypes), nil
 }
+
+// ImportState imports a code location by its name
+func (r *CodeLocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/datarootsio/terraform-provider-dagster/internal/client"
 	"github.com/datarootsio/terraform-provider-dagster/internal/client/service"
 	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/provider/jsontypes"
 	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,7 +21,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.Resource = &CodeLocationFromDocumentResource{}
+var (
+	_ resource.Resource                = &CodeLocationFromDocumentResource{}
+	_ resource.ResourceWithModifyPlan  = &CodeLocationFromDocumentResource{}
+	_ resource.ResourceWithImportState = &CodeLocationFromDocumentResource{}
+)
 
 func NewCodeLocationFromDocumentResource() resource.Resource {
 	return &CodeLocationFromDocumentResource{}
@@ -29,8 +36,8 @@ type CodeLocationFromDocumentResource struct {
 }
 
 type CodeLocationFromDocumentResourceModel struct {
-	Document types.String `tfsdk:"document"`
-	Name     types.String `tfsdk:"name"`
+	Document jsontypes.Document `tfsdk:"document"`
+	Name     types.String       `tfsdk:"name"`
 }
 
 func (r *CodeLocationFromDocumentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,8 +57,9 @@ func (r *CodeLocationFromDocumentResource) Schema(ctx context.Context, req resou
 				},
 			},
 			"document": schema.StringAttribute{
+				CustomType:          jsontypes.DocumentType{},
 				Required:            true,
-				MarkdownDescription: "Code location as a JSON document. We recommend using a `dagster_configuration_document` to generate this instead of composing a JSON document yourself.",
+				MarkdownDescription: "Code location as a JSON document. We recommend using a `dagster_configuration_document` to generate this instead of composing a JSON document yourself. Only the declared keys are tracked for changes.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIf(
 						replaceIfCodeLocationNameChanges,
@@ -98,6 +106,27 @@ func (r *CodeLocationFromDocumentResource) Configure(ctx context.Context, req re
 	r.client = client
 }
 
+// ModifyPlan validates the document against the code location schema of Dagster Cloud
+func (r *CodeLocationFromDocumentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan CodeLocationFromDocumentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Document.IsUnknown() || plan.Document.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCodeLocationDocument(ctx, r.client, plan.Document.ValueString(), path.Root("document"))...)
+}
+
 func (r *CodeLocationFromDocumentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data CodeLocationFromDocumentResourceModel
 
@@ -130,7 +159,7 @@ func (r *CodeLocationFromDocumentResource) Create(ctx context.Context, req resou
 	}
 
 	data.Name = types.StringValue(codeLocationName)
-	data.Document = types.StringValue(documentString)
+	data.Document = jsontypes.NewDocumentValue(documentString)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -144,11 +173,18 @@ func (r *CodeLocationFromDocumentResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	// Imported code locations only have a name, their document is read in full
+	imported := data.Document.IsNull()
+
+	codeLocationName := data.Name.ValueString()
 	document := json.RawMessage(data.Document.ValueString())
-	codeLocationName, err := service.GetCodeLocationNameFromDocument(document)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read code location, got error: %s", err))
-		return
+	if !imported {
+		var err error
+		codeLocationName, err = service.GetCodeLocationNameFromDocument(document)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read code location, got error: %s", err))
+			return
+		}
 	}
 
 	codeLocationAsDocument, err := r.client.CodeLocationsClient.GetCodeLocationAsDocumentByName(ctx, codeLocationName)
@@ -163,16 +199,20 @@ func (r *CodeLocationFromDocumentResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	documentString, err := utils.MakeJSONStringUniform(codeLocationAsDocument)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"JSON Format error",
-			fmt.Sprintf("Trying to parse JSON: %s: %s", document, err.Error()),
-		)
+	// Only track the declared keys, defaults filled in by Dagster Cloud are left out
+	documentString := string(codeLocationAsDocument)
+	if !imported {
+		documentString, err = utils.FilterJSONKeys(document, codeLocationAsDocument)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"JSON Format error",
+				fmt.Sprintf("Trying to parse JSON: %s: %s", document, err.Error()),
+			)
+		}
 	}
 
 	data.Name = types.StringValue(codeLocationName)
-	data.Document = types.StringValue(documentString)
+	data.Document = jsontypes.NewDocumentValue(documentString)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -211,7 +251,7 @@ func (r *CodeLocationFromDocumentResource) Update(ctx context.Context, req resou
 	}
 
 	data.Name = types.StringValue(codeLocationName)
-	data.Document = types.StringValue(documentString)
+	data.Document = jsontypes.NewDocumentValue(documentString)
 
 	tflog.Trace(ctx, "updated code location resource")
 
@@ -248,3 +288,30 @@ func (r *CodeLocationFromDocumentResource) Delete(ctx context.Context, req resou
 
 	tflog.Trace(ctx, fmt.Sprintf("deleted code location resource with id: %s", data.Name.ValueString()))
 }
+
+// ImportState imports a code location by its name, the full document of the code location is read into state
+func (r *CodeLocationFromDocumentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// validateCodeLocationDocument reports every key of the code location document that doesn't match the code location schema
+func validateCodeLocationDocument(ctx context.Context, client client.DagsterClient, document string, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configErrors, err := client.CodeLocationsClient.ValidateCodeLocationDocument(ctx, json.RawMessage(document))
+	if err != nil {
+		var errInvalid *clientTypes.ErrInvalid
+		if errors.As(err, &errInvalid) {
+			diags.AddAttributeError(attributePath, "Invalid code location document", err.Error())
+		} else {
+			diags.AddAttributeWarning(attributePath, "Unable to validate code location document", fmt.Sprintf("Unable to fetch the code location schema, got error: %s", err))
+		}
+		return diags
+	}
+
+	for _, configError := range configErrors {
+		diags.AddAttributeError(attributePath, "Invalid code location document", fmt.Sprintf("Code location %s", configError.Error()))
+	}
+
+	return diags
+}
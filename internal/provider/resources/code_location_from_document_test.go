@@ -2,6 +2,7 @@ package resources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
@@ -60,6 +61,41 @@ func TestAccResourceBasicCodeLocationFromDocument(t *testing.T) {
 					resource.TestCheckResourceAttr("dagster_code_location_from_document.test", "name", updatedName),
 				),
 			},
+			// Import by name, the imported document also holds the defaults filled in by Dagster Cloud
+			{
+				ResourceName:                         "dagster_code_location_from_document.test",
+				ImportState:                          true,
+				ImportStateId:                        updatedName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"document"},
+			},
+		},
+	})
+}
+
+func TestAccResourceInvalidCodeLocationFromDocument(t *testing.T) {
+	name := "code-location-as-document-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Documents are validated against the code location schema at plan time
+			{
+				Config: fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_code_location_from_document" "test" {
+  document = jsonencode({
+    location_name = "%s"
+    code_source = {
+      python_fil = "my_python.py"
+    }
+  })
+}
+`, name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Code location code_source.python_fil: unexpected key`),
+			},
 		},
 	})
 }
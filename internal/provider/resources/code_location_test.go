@@ -65,6 +65,14 @@ func TestAccResourceBasicCodeLocation(t *testing.T) {
 					resource.TestCheckResourceAttr("dagster_code_location.test", "code_source.python_file", file),
 				),
 			},
+			// Import by name
+			{
+				ResourceName:                         "dagster_code_location.test",
+				ImportState:                          true,
+				ImportStateId:                        updatedName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
 		},
 	})
 }
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strconv"
 
 	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
 	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/provider/jsontypes"
 	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +31,7 @@ import (
 var (
 	_ resource.Resource                = &DeploymentResource{}
 	_ resource.ResourceWithImportState = &DeploymentResource{}
+	_ resource.ResourceWithModifyPlan  = &DeploymentResource{}
 )
 
 func NewDeploymentResource() resource.Resource {
@@ -39,12 +43,15 @@ type DeploymentResource struct {
 }
 
 type DeploymentResourceModel struct {
-	Name         types.String `tfsdk:"name"`
-	Id           types.Int64  `tfsdk:"id"`
-	Status       types.String `tfsdk:"status"`
-	Type         types.String `tfsdk:"type"`
-	Settings     types.String `tfsdk:"settings_document"`
-	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
+	Name          types.String       `tfsdk:"name"`
+	Id            types.Int64        `tfsdk:"id"`
+	Status        types.String       `tfsdk:"status"`
+	Type          types.String       `tfsdk:"type"`
+	AgentType     types.String       `tfsdk:"agent_type"`
+	InheritFrom   types.String       `tfsdk:"inherit_permissions_from"`
+	Settings      jsontypes.Document `tfsdk:"settings_document"`
+	TypedSettings types.Object       `tfsdk:"settings"`
+	ForceDestroy  types.Bool         `tfsdk:"force_destroy"`
 }
 
 func (r *DeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -90,13 +97,29 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"agent_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(clientSchema.DeploymentAgentTypeHybrid)),
+				MarkdownDescription: "Agent type of the deployment (`HYBRID` or `SERVERLESS`). Changing the agent type updates the deployment in place. DEFAULT `HYBRID`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.DeploymentAgentTypeEnumValues()...),
+				},
+			},
+			"inherit_permissions_from": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Name or id of an existing deployment to copy the user and team permissions from when the deployment is created. " +
+					"Changing this attribute after the deployment is created has no effect.",
+			},
 			"settings_document": schema.StringAttribute{
+				CustomType:          jsontypes.DocumentType{},
 				Required:            false,
 				Computed:            true,
 				Optional:            true,
 				Default:             stringdefault.StaticString("{}"),
-				MarkdownDescription: "Deployment settings as a JSON document. We recommend using a `dagster_configuration_document` to generate this instead of composing a JSON document yourself. Leaving this attribute empty or partially filled in, will result in Dagster (partially) applying default settings to your deployment. This leads to perpetual changes in this resource.",
+				MarkdownDescription: "Deployment settings as a JSON document. We recommend using a `dagster_configuration_document` to generate this instead of composing a JSON document yourself. Settings that are left out are reset to the defaults of Dagster, only the declared settings are tracked for changes.",
 			},
+			"settings": deploymentSettingsAttribute(),
 			"force_destroy": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
@@ -125,6 +148,43 @@ func (r *DeploymentResource) Configure(ctx context.Context, req resource.Configu
 	r.client = client
 }
 
+// ModifyPlan serializes the typed settings into the settings document, so the document that is applied shows in the plan,
+// and validates the settings against the deployment settings schema of Dagster Cloud
+func (r *DeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsPath := path.Root("settings_document")
+	if !plan.TypedSettings.IsNull() {
+		settingsPath = path.Root("settings")
+
+		settingsDocument, diags := deploymentSettingsToDocument(ctx, plan.TypedSettings)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.Settings = settingsDocument
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+
+	if plan.Settings.IsUnknown() || plan.Settings.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDeploymentSettings(ctx, r.client, plan.Settings.ValueString(), false, settingsPath)...)
+}
+
 func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data DeploymentResourceModel
 
@@ -152,8 +212,24 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		)
 	}
 
+	agentType, err := clientTypes.ConvertToDeploymentAgentTypeEnum(data.AgentType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create deployment, got error: %s", err))
+		return
+	}
+
+	inheritPermsDeploymentId := 0
+	if !data.InheritFrom.IsNull() {
+		inheritFrom, err := r.getDeploymentByNameOrId(ctx, data.InheritFrom.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve deployment to inherit permissions from, got error: %s", err))
+			return
+		}
+		inheritPermsDeploymentId = inheritFrom.DeploymentId
+	}
+
 	// Create deployment
-	deployment, err := r.client.DeploymentClient.CreateHybridDeployment(ctx, data.Name.ValueString())
+	deployment, err := r.client.DeploymentClient.CreateDeployment(ctx, data.Name.ValueString(), agentType, inheritPermsDeploymentId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating deployment",
@@ -183,7 +259,8 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 	data.Id = types.Int64Value(int64(deployment.DeploymentId))
 	data.Status = types.StringValue(string(deployment.DeploymentStatus))
 	data.Type = types.StringValue(string(deployment.DeploymentType))
-	data.Settings = types.StringValue(settingsStr)
+	data.AgentType = types.StringValue(string(deployment.AgentType))
+	data.Settings = jsontypes.NewDocumentValue(settingsStr)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -211,7 +288,13 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	settings, err := utils.MakeJSONStringUniform(deployment.DeploymentSettings.Settings)
+	// Only track the declared settings, unless nothing is declared yet, for example after an import
+	var settings string
+	if data.Settings.IsNull() {
+		settings, err = utils.MakeJSONStringUniform(deployment.DeploymentSettings.Settings)
+	} else {
+		settings, err = utils.FilterJSONKeys(json.RawMessage(data.Settings.ValueString()), deployment.DeploymentSettings.Settings)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"JSON Format error",
@@ -219,18 +302,30 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 		)
 	}
 
-	data.Settings = types.StringValue(settings)
+	data.Settings = jsontypes.NewDocumentValue(settings)
+
+	if !data.TypedSettings.IsNull() {
+		typedSettings, diags := deploymentSettingsFromDocument(ctx, data.TypedSettings, deployment.DeploymentSettings.Settings)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.TypedSettings = typedSettings
+	}
+
 	data.Name = types.StringValue(deployment.DeploymentName)
 	data.Id = types.Int64Value(int64(deployment.DeploymentId))
 	data.Status = types.StringValue(string(deployment.DeploymentStatus))
 	data.Type = types.StringValue(string(deployment.DeploymentType))
+	data.AgentType = types.StringValue(string(deployment.AgentType))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan DeploymentResourceModel
+	var plan, state DeploymentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -245,6 +340,21 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		)
 	}
 
+	// Switch agent type in place
+	if !plan.AgentType.Equal(state.AgentType) {
+		agentType, err := clientTypes.ConvertToDeploymentAgentTypeEnum(plan.AgentType.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update deployment, got error: %s", err))
+			return
+		}
+
+		deploy, err = r.client.DeploymentClient.UpdateDeploymentAgentType(ctx, deploy.DeploymentId, agentType)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update agent type of deployment, got error: %s", err))
+			return
+		}
+	}
+
 	// Set new settings
 	tflog.Trace(ctx, fmt.Sprintf("Applying settings to deployment %v: %v", deploymentName, string(deploymentSettings)))
 	settings, err := r.client.DeploymentClient.SetDeploymentSettings(ctx, deploy.DeploymentId, deploymentSettings)
@@ -264,7 +374,8 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 	plan.Status = types.StringValue(string(deploy.DeploymentStatus))
 	plan.Name = types.StringValue(deploy.DeploymentName)
 	plan.Type = types.StringValue(string(deploy.DeploymentType))
-	plan.Settings = types.StringValue(settingsStr)
+	plan.AgentType = types.StringValue(string(deploy.AgentType))
+	plan.Settings = jsontypes.NewDocumentValue(settingsStr)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -312,3 +423,19 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 func (r *DeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// getDeploymentByNameOrId looks up a deployment by name, falling back to the id for numeric values that are not a deployment name
+func (r *DeploymentResource) getDeploymentByNameOrId(ctx context.Context, nameOrId string) (clientSchema.Deployment, error) {
+	deployment, err := r.client.DeploymentClient.GetDeploymentByName(ctx, nameOrId)
+	if err == nil {
+		return deployment, nil
+	}
+
+	var errComp *clientTypes.ErrNotFound
+	id, errId := strconv.Atoi(nameOrId)
+	if !errors.As(err, &errComp) || errId != nil {
+		return clientSchema.Deployment{}, err
+	}
+
+	return r.client.DeploymentClient.GetDeploymentById(ctx, id)
+}
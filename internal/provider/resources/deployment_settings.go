@@ -0,0 +1,262 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/provider/jsontypes"
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &DeploymentSettingsResource{}
+	_ resource.ResourceWithImportState = &DeploymentSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &DeploymentSettingsResource{}
+)
+
+const (
+	deploymentSettingsModeReplace = "REPLACE"
+	deploymentSettingsModeMerge   = "MERGE"
+)
+
+func NewDeploymentSettingsResource() resource.Resource {
+	return &DeploymentSettingsResource{}
+}
+
+type DeploymentSettingsResource struct {
+	client client.DagsterClient
+}
+
+type DeploymentSettingsResourceModel struct {
+	DeploymentId     types.Int64        `tfsdk:"deployment_id"`
+	Mode             types.String       `tfsdk:"mode"`
+	SettingsDocument jsontypes.Document `tfsdk:"settings_document"`
+}
+
+func (r *DeploymentSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_settings"
+}
+
+func (r *DeploymentSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the settings of an existing deployment, for example a deployment that is not managed by Terraform. " +
+			"This resource never deletes the deployment: destroying it only removes the settings from the Terraform state and leaves them as they are.",
+
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the deployment to manage the settings of",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(deploymentSettingsModeReplace),
+				MarkdownDescription: "How the settings are applied. `REPLACE` replaces all settings of the deployment with `settings_document`, " +
+					"settings that are left out are reset to their defaults. " +
+					"`MERGE` merges `settings_document` into the existing settings. In both modes only the declared keys are tracked for changes. DEFAULT `REPLACE`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(deploymentSettingsModeReplace, deploymentSettingsModeMerge),
+				},
+			},
+			"settings_document": schema.StringAttribute{
+				CustomType:          jsontypes.DocumentType{},
+				Required:            true,
+				MarkdownDescription: "Deployment settings as a JSON document. We recommend using a `dagster_configuration_document` to generate this instead of composing a JSON document yourself.",
+			},
+		},
+	}
+}
+
+func (r *DeploymentSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan validates the settings document against the deployment settings schema of Dagster Cloud
+func (r *DeploymentSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DeploymentSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SettingsDocument.IsUnknown() || plan.Mode.IsUnknown() {
+		return
+	}
+
+	partial := plan.Mode.ValueString() == deploymentSettingsModeMerge
+	resp.Diagnostics.Append(validateDeploymentSettings(ctx, r.client, plan.SettingsDocument.ValueString(), partial, path.Root("settings_document"))...)
+}
+
+func (r *DeploymentSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyDeploymentSettings(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply deployment settings, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("applied settings to deployment with id: %d", data.DeploymentId.ValueInt64()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeploymentSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.client.DeploymentClient.GetDeploymentById(ctx, int(data.DeploymentId.ValueInt64()))
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Deployment not found, probably already deleted, removing settings from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment settings, got error: %s", err))
+		}
+		return
+	}
+
+	// Only track the declared keys, defaults filled in by Dagster Cloud are left out
+	settings, err := utils.FilterJSONKeys(json.RawMessage(data.SettingsDocument.ValueString()), deployment.DeploymentSettings.Settings)
+	if err != nil {
+		resp.Diagnostics.AddError("JSON Format error", fmt.Sprintf("Unable to parse deployment settings, got error: %s", err))
+		return
+	}
+
+	data.SettingsDocument = jsontypes.NewDocumentValue(settings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeploymentSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyDeploymentSettings(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply deployment settings, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated deployment settings resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeploymentSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The deployment and its settings are left untouched, they are only removed from the state
+	tflog.Trace(ctx, fmt.Sprintf("removed settings of deployment with id %d from state", data.DeploymentId.ValueInt64()))
+}
+
+func (r *DeploymentSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", fmt.Sprintf("Expected the numeric id of the deployment, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mode"), deploymentSettingsModeReplace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("settings_document"), jsontypes.NewDocumentValue("{}"))...)
+}
+
+// applyDeploymentSettings replaces or merges the settings of the deployment, depending on the mode
+func (r *DeploymentSettingsResource) applyDeploymentSettings(ctx context.Context, data DeploymentSettingsResourceModel) error {
+	deploymentId := int(data.DeploymentId.ValueInt64())
+	settings := json.RawMessage(data.SettingsDocument.ValueString())
+
+	var err error
+	if data.Mode.ValueString() == deploymentSettingsModeMerge {
+		_, err = r.client.DeploymentClient.UpdateDeploymentSettings(ctx, deploymentId, settings)
+	} else {
+		_, err = r.client.DeploymentClient.SetDeploymentSettings(ctx, deploymentId, settings)
+	}
+
+	return err
+}
+
+// validateDeploymentSettings reports every key of the settings document that doesn't match the deployment settings schema
+func validateDeploymentSettings(ctx context.Context, client client.DagsterClient, settings string, partial bool, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configErrors, err := client.DeploymentClient.ValidateDeploymentSettings(ctx, json.RawMessage(settings), partial)
+	if err != nil {
+		var errInvalid *clientTypes.ErrInvalid
+		if errors.As(err, &errInvalid) {
+			diags.AddAttributeError(attributePath, "Invalid deployment settings", err.Error())
+		} else {
+			diags.AddAttributeWarning(attributePath, "Unable to validate deployment settings", fmt.Sprintf("Unable to fetch the deployment settings schema, got error: %s", err))
+		}
+		return diags
+	}
+
+	for _, configError := range configErrors {
+		diags.AddAttributeError(attributePath, "Invalid deployment settings", fmt.Sprintf("Deployment setting %s", configError.Error()))
+	}
+
+	return diags
+}
@@ -0,0 +1,442 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/provider/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// deploymentSettingsModel is the typed alternative for the settings_document of a deployment,
+// only the settings that are set are sent to Dagster Cloud and tracked for changes
+type deploymentSettingsModel struct {
+	RunQueue        *runQueueSettingsModel        `tfsdk:"run_queue"`
+	RunMonitoring   *runMonitoringSettingsModel   `tfsdk:"run_monitoring"`
+	RunRetries      *runRetriesSettingsModel      `tfsdk:"run_retries"`
+	SSODefaultRole  types.String                  `tfsdk:"sso_default_role"`
+	NonIsolatedRuns *nonIsolatedRunsSettingsModel `tfsdk:"non_isolated_runs"`
+	AutoMaterialize *autoMaterializeSettingsModel `tfsdk:"auto_materialize"`
+}
+
+type runQueueSettingsModel struct {
+	MaxConcurrentRuns    types.Int64                `tfsdk:"max_concurrent_runs"`
+	TagConcurrencyLimits []tagConcurrencyLimitModel `tfsdk:"tag_concurrency_limits"`
+}
+
+type tagConcurrencyLimitModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+	Limit types.Int64  `tfsdk:"limit"`
+}
+
+type runMonitoringSettingsModel struct {
+	StartTimeoutSeconds         types.Int64 `tfsdk:"start_timeout_seconds"`
+	CancelTimeoutSeconds        types.Int64 `tfsdk:"cancel_timeout_seconds"`
+	FreeSlotsAfterRunEndSeconds types.Int64 `tfsdk:"free_slots_after_run_end_seconds"`
+}
+
+type runRetriesSettingsModel struct {
+	MaxRetries              types.Int64 `tfsdk:"max_retries"`
+	RetryOnAssetOrOpFailure types.Bool  `tfsdk:"retry_on_asset_or_op_failure"`
+}
+
+type nonIsolatedRunsSettingsModel struct {
+	MaxConcurrentNonIsolatedRuns types.Int64 `tfsdk:"max_concurrent_non_isolated_runs"`
+}
+
+type autoMaterializeSettingsModel struct {
+	RunTags                            types.Map  `tfsdk:"run_tags"`
+	RespectMaterializationDataVersions types.Bool `tfsdk:"respect_materialization_data_versions"`
+	UseSensors                         types.Bool `tfsdk:"use_sensors"`
+}
+
+func deploymentSettingsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		MarkdownDescription: "Deployment settings as a typed block, an alternative for `settings_document` that is checked at plan time. " +
+			"Only the settings that are set are tracked for changes, the other settings are reset to the defaults of Dagster.",
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRoot("settings_document")),
+		},
+		Attributes: map[string]schema.Attribute{
+			"run_queue": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Run queue settings",
+				Attributes: map[string]schema.Attribute{
+					"max_concurrent_runs": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum number of runs that are allowed to be in progress at once",
+					},
+					"tag_concurrency_limits": schema.ListNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Limits on the number of runs in progress with a given tag",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"key": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "Tag key",
+								},
+								"value": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "Tag value, the limit applies to all values of the tag when not set",
+								},
+								"limit": schema.Int64Attribute{
+									Required:            true,
+									MarkdownDescription: "Maximum number of runs in progress with the tag",
+								},
+							},
+						},
+					},
+				},
+			},
+			"run_monitoring": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Run monitoring settings",
+				Attributes: map[string]schema.Attribute{
+					"start_timeout_seconds": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of seconds a run can take to start before it's marked as failed",
+					},
+					"cancel_timeout_seconds": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of seconds a run can take to cancel before it's marked as canceled",
+					},
+					"free_slots_after_run_end_seconds": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of seconds after which the concurrency slots of a finished run are freed",
+					},
+				},
+			},
+			"run_retries": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Run retries settings",
+				Attributes: map[string]schema.Attribute{
+					"max_retries": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum number of times a failed run is retried",
+					},
+					"retry_on_asset_or_op_failure": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Whether runs that failed because of an asset or op failure are retried",
+					},
+				},
+			},
+			"sso_default_role": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Default role of users that log in with SSO, one of `" + strings.Join(clientTypes.DeploymentGrantEnumValues(), "`, `") + "`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.DeploymentGrantEnumValues()...),
+				},
+			},
+			"non_isolated_runs": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Non-isolated runs settings",
+				Attributes: map[string]schema.Attribute{
+					"max_concurrent_non_isolated_runs": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum number of non-isolated runs that are allowed to be in progress at once",
+					},
+				},
+			},
+			"auto_materialize": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Auto-materialize settings",
+				Attributes: map[string]schema.Attribute{
+					"run_tags": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "Tags added to the runs launched by auto-materialize",
+					},
+					"respect_materialization_data_versions": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Whether auto-materialize respects the data versions of materializations",
+					},
+					"use_sensors": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Whether auto-materialize is evaluated by sensors",
+					},
+				},
+			},
+		},
+	}
+}
+
+// deploymentSettingsAttributeTypes returns the attribute types of the settings block
+func deploymentSettingsAttributeTypes() map[string]attr.Type {
+	return deploymentSettingsAttribute().GetType().(types.ObjectType).AttrTypes
+}
+
+// deploymentSettingsToDocument serializes the settings block into a settings document, which is unknown as long as the block is not fully known
+func deploymentSettingsToDocument(ctx context.Context, settings types.Object) (jsontypes.Document, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settingsValue, err := settings.ToTerraformValue(ctx)
+	if err != nil {
+		diags.AddError("Invalid settings", fmt.Sprintf("Unable to read settings, got error: %s", err))
+		return jsontypes.NewDocumentNull(), diags
+	}
+	if !settingsValue.IsFullyKnown() {
+		return jsontypes.NewDocumentUnknown(), diags
+	}
+
+	var model deploymentSettingsModel
+	diags.Append(settings.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return jsontypes.NewDocumentNull(), diags
+	}
+
+	document, err := model.toDocument()
+	if err != nil {
+		diags.AddError("JSON Format error", fmt.Sprintf("Unable to serialize settings, got error: %s", err))
+		return jsontypes.NewDocumentNull(), diags
+	}
+
+	return jsontypes.NewDocumentValue(document), diags
+}
+
+// deploymentSettingsFromDocument updates the settings that are set in the settings block with the settings of the deployment
+func deploymentSettingsFromDocument(ctx context.Context, settings types.Object, document json.RawMessage) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var model deploymentSettingsModel
+	diags.Append(settings.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return settings, diags
+	}
+
+	model, err := model.withValuesFromDocument(document)
+	if err != nil {
+		diags.AddError("JSON Format error", fmt.Sprintf("Unable to parse deployment settings, got error: %s", err))
+		return settings, diags
+	}
+
+	settingsObject, objectDiags := types.ObjectValueFrom(ctx, deploymentSettingsAttributeTypes(), model)
+	diags.Append(objectDiags...)
+
+	return settingsObject, diags
+}
+
+// toDocument serializes the settings that are set into a deployment settings document
+func (m deploymentSettingsModel) toDocument() (string, error) {
+	document := map[string]interface{}{}
+
+	if m.RunQueue != nil {
+		runQueue := map[string]interface{}{}
+		setInt64(runQueue, "max_concurrent_runs", m.RunQueue.MaxConcurrentRuns)
+		if m.RunQueue.TagConcurrencyLimits != nil {
+			limits := make([]map[string]interface{}, 0, len(m.RunQueue.TagConcurrencyLimits))
+			for _, limit := range m.RunQueue.TagConcurrencyLimits {
+				limitDocument := map[string]interface{}{}
+				setString(limitDocument, "key", limit.Key)
+				setString(limitDocument, "value", limit.Value)
+				setInt64(limitDocument, "limit", limit.Limit)
+				limits = append(limits, limitDocument)
+			}
+			runQueue["tag_concurrency_limits"] = limits
+		}
+		document["run_queue"] = runQueue
+	}
+
+	if m.RunMonitoring != nil {
+		runMonitoring := map[string]interface{}{}
+		setInt64(runMonitoring, "start_timeout_seconds", m.RunMonitoring.StartTimeoutSeconds)
+		setInt64(runMonitoring, "cancel_timeout_seconds", m.RunMonitoring.CancelTimeoutSeconds)
+		setInt64(runMonitoring, "free_slots_after_run_end_seconds", m.RunMonitoring.FreeSlotsAfterRunEndSeconds)
+		document["run_monitoring"] = runMonitoring
+	}
+
+	if m.RunRetries != nil {
+		runRetries := map[string]interface{}{}
+		setInt64(runRetries, "max_retries", m.RunRetries.MaxRetries)
+		setBool(runRetries, "retry_on_asset_or_op_failure", m.RunRetries.RetryOnAssetOrOpFailure)
+		document["run_retries"] = runRetries
+	}
+
+	setString(document, "sso_default_role", m.SSODefaultRole)
+
+	if m.NonIsolatedRuns != nil {
+		nonIsolatedRuns := map[string]interface{}{}
+		setInt64(nonIsolatedRuns, "max_concurrent_non_isolated_runs", m.NonIsolatedRuns.MaxConcurrentNonIsolatedRuns)
+		document["non_isolated_runs"] = nonIsolatedRuns
+	}
+
+	if m.AutoMaterialize != nil {
+		autoMaterialize := map[string]interface{}{}
+		if !m.AutoMaterialize.RunTags.IsNull() {
+			runTags := map[string]string{}
+			for key, value := range m.AutoMaterialize.RunTags.Elements() {
+				if stringValue, ok := value.(types.String); ok {
+					runTags[key] = stringValue.ValueString()
+				}
+			}
+			autoMaterialize["run_tags"] = runTags
+		}
+		setBool(autoMaterialize, "respect_materialization_data_versions", m.AutoMaterialize.RespectMaterializationDataVersions)
+		setBool(autoMaterialize, "use_sensors", m.AutoMaterialize.UseSensors)
+		document["auto_materialize"] = autoMaterialize
+	}
+
+	output, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+// withValuesFromDocument returns a copy of the settings with the values from the settings of the deployment,
+// settings that are not set stay unset
+func (m deploymentSettingsModel) withValuesFromDocument(settings json.RawMessage) (deploymentSettingsModel, error) {
+	var document map[string]interface{}
+	if err := json.Unmarshal(settings, &document); err != nil {
+		return deploymentSettingsModel{}, err
+	}
+
+	if m.RunQueue != nil {
+		section := getSection(document, "run_queue")
+		runQueue := runQueueSettingsModel{
+			MaxConcurrentRuns: getInt64(section, "max_concurrent_runs", m.RunQueue.MaxConcurrentRuns),
+		}
+		if m.RunQueue.TagConcurrencyLimits != nil {
+			runQueue.TagConcurrencyLimits = []tagConcurrencyLimitModel{}
+			limits, _ := section["tag_concurrency_limits"].([]interface{})
+			for _, limit := range limits {
+				limitDocument, ok := limit.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				runQueue.TagConcurrencyLimits = append(runQueue.TagConcurrencyLimits, tagConcurrencyLimitModel{
+					Key:   getString(limitDocument, "key", types.StringValue("")),
+					Value: getString(limitDocument, "value", types.StringValue("")),
+					Limit: getInt64(limitDocument, "limit", types.Int64Value(0)),
+				})
+			}
+		}
+		m.RunQueue = &runQueue
+	}
+
+	if m.RunMonitoring != nil {
+		section := getSection(document, "run_monitoring")
+		m.RunMonitoring = &runMonitoringSettingsModel{
+			StartTimeoutSeconds:         getInt64(section, "start_timeout_seconds", m.RunMonitoring.StartTimeoutSeconds),
+			CancelTimeoutSeconds:        getInt64(section, "cancel_timeout_seconds", m.RunMonitoring.CancelTimeoutSeconds),
+			FreeSlotsAfterRunEndSeconds: getInt64(section, "free_slots_after_run_end_seconds", m.RunMonitoring.FreeSlotsAfterRunEndSeconds),
+		}
+	}
+
+	if m.RunRetries != nil {
+		section := getSection(document, "run_retries")
+		m.RunRetries = &runRetriesSettingsModel{
+			MaxRetries:              getInt64(section, "max_retries", m.RunRetries.MaxRetries),
+			RetryOnAssetOrOpFailure: getBool(section, "retry_on_asset_or_op_failure", m.RunRetries.RetryOnAssetOrOpFailure),
+		}
+	}
+
+	m.SSODefaultRole = getString(document, "sso_default_role", m.SSODefaultRole)
+
+	if m.NonIsolatedRuns != nil {
+		section := getSection(document, "non_isolated_runs")
+		m.NonIsolatedRuns = &nonIsolatedRunsSettingsModel{
+			MaxConcurrentNonIsolatedRuns: getInt64(section, "max_concurrent_non_isolated_runs", m.NonIsolatedRuns.MaxConcurrentNonIsolatedRuns),
+		}
+	}
+
+	if m.AutoMaterialize != nil {
+		section := getSection(document, "auto_materialize")
+		autoMaterialize := autoMaterializeSettingsModel{
+			RunTags:                            m.AutoMaterialize.RunTags,
+			RespectMaterializationDataVersions: getBool(section, "respect_materialization_data_versions", m.AutoMaterialize.RespectMaterializationDataVersions),
+			UseSensors:                         getBool(section, "use_sensors", m.AutoMaterialize.UseSensors),
+		}
+		if !m.AutoMaterialize.RunTags.IsNull() {
+			runTags := map[string]attr.Value{}
+			runTagsDocument, _ := section["run_tags"].(map[string]interface{})
+			for key, value := range runTagsDocument {
+				if stringValue, ok := value.(string); ok {
+					runTags[key] = types.StringValue(stringValue)
+				}
+			}
+			autoMaterialize.RunTags = types.MapValueMust(types.StringType, runTags)
+		}
+		m.AutoMaterialize = &autoMaterialize
+	}
+
+	return m, nil
+}
+
+func setInt64(document map[string]interface{}, key string, value types.Int64) {
+	if !value.IsNull() {
+		document[key] = value.ValueInt64()
+	}
+}
+
+func setBool(document map[string]interface{}, key string, value types.Bool) {
+	if !value.IsNull() {
+		document[key] = value.ValueBool()
+	}
+}
+
+func setString(document map[string]interface{}, key string, value types.String) {
+	if !value.IsNull() {
+		document[key] = value.ValueString()
+	}
+}
+
+func getSection(document map[string]interface{}, key string) map[string]interface{} {
+	section, _ := document[key].(map[string]interface{})
+	return section
+}
+
+// getInt64 returns the value of a key in the document when the current value is set, a missing key results in null
+func getInt64(document map[string]interface{}, key string, current types.Int64) types.Int64 {
+	if current.IsNull() {
+		return current
+	}
+
+	value, ok := document[key].(float64)
+	if !ok {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(value))
+}
+
+// getBool returns the value of a key in the document when the current value is set, a missing key results in null
+func getBool(document map[string]interface{}, key string, current types.Bool) types.Bool {
+	if current.IsNull() {
+		return current
+	}
+
+	value, ok := document[key].(bool)
+	if !ok {
+		return types.BoolNull()
+	}
+
+	return types.BoolValue(value)
+}
+
+// getString returns the value of a key in the document when the current value is set, a missing key results in null
+func getString(document map[string]interface{}, key string, current types.String) types.String {
+	if current.IsNull() {
+		return current
+	}
+
+	value, ok := document[key].(string)
+	if !ok {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
@@ -0,0 +1,100 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccResourceDeploymentSettingsConfig(name string, mode string, ssoDefaultRole string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_deployment" "test" {
+  name          = "%s"
+  force_destroy = true
+
+  lifecycle {
+    ignore_changes = [settings_document]
+  }
+}
+
+resource "dagster_deployment_settings" "test" {
+  deployment_id = dagster_deployment.test.id
+  mode          = "%s"
+  settings_document = jsonencode({
+    sso_default_role = "%s"
+  })
+}
+`, name, mode, ssoDefaultRole)
+}
+
+func TestAccResourceDeploymentSettingsMerge(t *testing.T) {
+	deploymentName := "deployment-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Settings are validated against the deployment settings schema at plan time
+			{
+				Config:      testAccResourceDeploymentSettingsConfig(deploymentName, "MERGE", "NOT_A_ROLE"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Deployment setting sso_default_role: expected one of`),
+			},
+			{
+				Config: testAccResourceDeploymentSettingsConfig(deploymentName, "MERGE", "LAUNCHER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_deployment_settings.test", "settings_document", `{"sso_default_role":"LAUNCHER"}`),
+				),
+			},
+			{
+				Config: testAccResourceDeploymentSettingsConfig(deploymentName, "MERGE", "EDITOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_deployment_settings.test", "settings_document", `{"sso_default_role":"EDITOR"}`),
+				),
+			},
+			// Destroying the settings leaves the deployment in place
+			{
+				Config: testutils.ProviderConfig + fmt.Sprintf(`
+resource "dagster_deployment" "test" {
+  name          = "%s"
+  force_destroy = true
+
+  lifecycle {
+    ignore_changes = [settings_document]
+  }
+}
+`, deploymentName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_deployment.test", "name", deploymentName),
+					resource.TestCheckResourceAttr("dagster_deployment.test", "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDeploymentSettingsReplace(t *testing.T) {
+	deploymentName := "deployment-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDeploymentSettingsConfig(deploymentName, "REPLACE", "LAUNCHER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_deployment_settings.test", "settings_document", `{"sso_default_role":"LAUNCHER"}`),
+				),
+			},
+			// Defaults filled in by Dagster Cloud for the other settings don't show up as changes
+			{
+				Config:   testAccResourceDeploymentSettingsConfig(deploymentName, "REPLACE", "LAUNCHER"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
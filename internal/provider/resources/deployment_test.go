@@ -2,6 +2,7 @@ package resources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
@@ -64,3 +65,183 @@ func TestAccResourceBasicDeployment(t *testing.T) {
 		},
 	})
 }
+
+func testAccResourceDeploymentAgentTypeConfig(name string, agentType string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_deployment" "this" {
+  name          = "%s"
+  agent_type    = "%s"
+  force_destroy = true
+}
+`, name, agentType)
+}
+
+func TestAccResourceDeploymentAgentType(t *testing.T) {
+	deploymentName := "deployment-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDeploymentAgentTypeConfig(deploymentName, "HYBRID"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_deployment.this", "agent_type", "HYBRID"),
+					testutils.FetchValueFromState("dagster_deployment.this", "id", &id),
+				),
+			},
+			// Switching the agent type keeps the deployment
+			{
+				Config: testAccResourceDeploymentAgentTypeConfig(deploymentName, "SERVERLESS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_deployment.this", "agent_type", "SERVERLESS"),
+					resource.TestCheckResourceAttrPtr("dagster_deployment.this", "id", &id),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDeploymentInheritPermissionsConfig(teamName string, deploymentName string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+data "dagster_current_deployment" "current" {}
+
+resource "dagster_team" "this" {
+  name = "%s"
+}
+
+resource "dagster_team_deployment_grant" "this" {
+  deployment_id = data.dagster_current_deployment.current.id
+  team_id       = dagster_team.this.id
+  grant         = "VIEWER"
+}
+
+resource "dagster_deployment" "this" {
+  name                     = "%s"
+  inherit_permissions_from = data.dagster_current_deployment.current.name
+  force_destroy            = true
+
+  depends_on = [dagster_team_deployment_grant.this]
+}
+`, teamName, deploymentName)
+}
+
+func TestAccResourceDeploymentInheritPermissions(t *testing.T) {
+	teamName := "team-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	deploymentName := "deployment-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	var teamId, deploymentId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDeploymentInheritPermissionsConfig(teamName, deploymentName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("dagster_deployment.this", "inherit_permissions_from", "data.dagster_current_deployment.current", "name"),
+					testutils.FetchValueFromState("dagster_team.this", "id", &teamId),
+					testutils.FetchValueFromState("dagster_deployment.this", "id", &deploymentId),
+					testGrantProperties(&teamId, &deploymentId, "VIEWER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDeploymentPartialSettingsConfig(name string, maxConcurrentRuns int) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_deployment" "this" {
+  name          = "%s"
+  force_destroy = true
+  settings_document = jsonencode({
+    run_queue = {
+      max_concurrent_runs = %d
+    }
+  })
+}
+`, name, maxConcurrentRuns)
+}
+
+// Partial settings documents don't cause perpetual changes, which the empty plan check after each step verifies
+func TestAccResourceDeploymentPartialSettings(t *testing.T) {
+	deploymentName := "deployment-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDeploymentPartialSettingsConfig(deploymentName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_deployment.this", "settings_document", `{"run_queue":{"max_concurrent_runs":10}}`),
+				),
+			},
+			{
+				Config: testAccResourceDeploymentPartialSettingsConfig(deploymentName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_deployment.this", "settings_document", `{"run_queue":{"max_concurrent_runs":20}}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDeploymentTypedSettingsConfig(name string, maxConcurrentRuns int, ssoDefaultRole string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_deployment" "this" {
+  name          = "%s"
+  force_destroy = true
+
+  settings = {
+    run_queue = {
+      max_concurrent_runs = %d
+      tag_concurrency_limits = [
+        {
+          key   = "database"
+          value = "redshift"
+          limit = 2
+        }
+      ]
+    }
+    run_retries = {
+      max_retries = 1
+    }
+    sso_default_role = "%s"
+  }
+}
+`, name, maxConcurrentRuns, ssoDefaultRole)
+}
+
+func TestAccResourceDeploymentTypedSettings(t *testing.T) {
+	deploymentName := "deployment-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid settings are rejected at plan time
+			{
+				Config:      testAccResourceDeploymentTypedSettingsConfig(deploymentName, 10, "OWNER"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: testAccResourceDeploymentTypedSettingsConfig(deploymentName, 10, "VIEWER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_deployment.this", "settings.run_queue.max_concurrent_runs", "10"),
+					resource.TestCheckResourceAttr("dagster_deployment.this", "settings.run_queue.tag_concurrency_limits.0.limit", "2"),
+					resource.TestCheckResourceAttr("dagster_deployment.this", "settings.sso_default_role", "VIEWER"),
+					resource.TestCheckResourceAttr("dagster_deployment.this", "settings_document", `{"run_queue":{"max_concurrent_runs":10,"tag_concurrency_limits":[{"key":"database","limit":2,"value":"redshift"}]},"run_retries":{"max_retries":1},"sso_default_role":"VIEWER"}`),
+				),
+			},
+			{
+				Config: testAccResourceDeploymentTypedSettingsConfig(deploymentName, 20, "LAUNCHER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_deployment.this", "settings.run_queue.max_concurrent_runs", "20"),
+					resource.TestCheckResourceAttr("dagster_deployment.this", "settings.sso_default_role", "LAUNCHER"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,448 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &SecretResource{}
+	_ resource.ResourceWithImportState      = &SecretResource{}
+	_ resource.ResourceWithModifyPlan       = &SecretResource{}
+	_ resource.ResourceWithConfigValidators = &SecretResource{}
+)
+
+func NewSecretResource() resource.Resource {
+	return &SecretResource{}
+}
+
+type SecretResource struct {
+	client client.DagsterClient
+}
+
+type SecretResourceModel struct {
+	Id                            types.String  `tfsdk:"id"`
+	Name                          types.String  `tfsdk:"name"`
+	ValueFile                     types.String  `tfsdk:"value_file"`
+	ValueEnv                      types.String  `tfsdk:"value_env"`
+	ValueHash                     types.String  `tfsdk:"value_hash"`
+	HashSalt                      types.String  `tfsdk:"hash_salt"`
+	FullDeploymentScope           types.Bool    `tfsdk:"full_deployment_scope"`
+	AllBranchDeploymentsScope     types.Bool    `tfsdk:"all_branch_deployments_scope"`
+	SpecificBranchDeploymentScope types.String  `tfsdk:"specific_branch_deployment_scope"`
+	LocalDeploymentScope          types.Bool    `tfsdk:"local_deployment_scope"`
+	LocationNames                 types.Set     `tfsdk:"location_names"`
+	UpdateTimestamp               types.Float64 `tfsdk:"update_timestamp"`
+}
+
+func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a secret (environment variable) in the deployment the provider is configured with. " +
+			"The secret value is never stored in the state: it is read from a file or an environment variable and only its salted HMAC-SHA-256 is tracked.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Secret id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Secret name, this is the name of the environment variable exposed to the code locations",
+			},
+			"value_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a file containing the secret value. The file contents are used as is, including trailing newlines. Exactly one of `value_file` or `value_env` is required.",
+			},
+			"value_env": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the environment variable containing the secret value. Exactly one of `value_file` or `value_env` is required.",
+			},
+			"value_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "HMAC-SHA-256 of the secret value, keyed by `hash_salt`. When the value can't be viewed with the configured API token, drift is detected through `update_timestamp` instead.",
+			},
+			"hash_salt": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Salt of `value_hash`, derived from the API token when the resource is created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"full_deployment_scope": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Make the secret available in the full deployment. DEFAULT `true`",
+			},
+			"all_branch_deployments_scope": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Make the secret available in all branch deployments. DEFAULT `false`",
+			},
+			"specific_branch_deployment_scope": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Make the secret available in the branch deployment of this git branch only",
+			},
+			"local_deployment_scope": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Make the secret available in local development. DEFAULT `false`",
+			},
+			"location_names": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Code locations the secret is available to. An empty set makes the secret available to all code locations. DEFAULT `[]`",
+			},
+			"update_timestamp": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the last update of the secret",
+			},
+		},
+	}
+}
+
+func (r *SecretResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("value_file"),
+			path.MatchRoot("value_env"),
+		),
+	}
+}
+
+func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan computes the hash of the secret value, so that a changed file or environment variable shows up in the plan
+func (r *SecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SecretResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The salt is only derived when the resource is created
+	if plan.HashSalt.IsUnknown() && !plan.Name.IsUnknown() {
+		plan.HashSalt = types.StringValue(r.secretHashSalt(plan.Name))
+	}
+
+	switch {
+	case plan.ValueFile.IsUnknown() || plan.ValueEnv.IsUnknown() || plan.HashSalt.IsUnknown():
+		plan.ValueHash = types.StringUnknown()
+	case !plan.ValueFile.IsNull() && !fileExists(plan.ValueFile.ValueString()):
+		// The file might be created by another resource during the apply
+		plan.ValueHash = types.StringUnknown()
+	default:
+		value, err := loadSecretValue(plan.ValueFile, plan.ValueEnv)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to load secret value", err.Error())
+			return
+		}
+		plan.ValueHash = types.StringValue(utils.HashStringWithSalt(plan.HashSalt.ValueString(), value))
+	}
+
+	// A new value is only detected here, so the update timestamp has to be marked as unknown as well
+	if !req.State.Raw.IsNull() {
+		var state SecretResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !state.ValueHash.Equal(plan.ValueHash) {
+			plan.UpdateTimestamp = types.Float64Unknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := loadSecretValue(data.ValueFile, data.ValueEnv)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to load secret value", err.Error())
+		return
+	}
+
+	locationNames, diags := secretLocationNamesFromSet(ctx, data.LocationNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.SecretsClient.CreateSecret(
+		ctx,
+		data.Name.ValueString(),
+		value,
+		secretScopesInput(data.FullDeploymentScope, data.AllBranchDeploymentsScope, data.SpecificBranchDeploymentScope, data.LocalDeploymentScope),
+		locationNames,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setSecretResourceModel(ctx, &data, secret)...)
+	if data.HashSalt.IsUnknown() {
+		data.HashSalt = types.StringValue(r.secretHashSalt(data.Name))
+	}
+	data.ValueHash = types.StringValue(utils.HashStringWithSalt(data.HashSalt.ValueString(), value))
+
+	tflog.Trace(ctx, fmt.Sprintf("created secret resource with id: %s", secret.Id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Secrets are imported via name, in that case the id is still null
+	var secret clientSchema.Secret
+	var err error
+	if !data.Id.IsNull() {
+		secret, err = r.client.SecretsClient.GetSecretById(ctx, data.Id.ValueString())
+	} else {
+		secret, err = r.client.SecretsClient.GetSecretByName(ctx, data.Name.ValueString())
+	}
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) && !data.Id.IsNull() {
+			tflog.Trace(ctx, "Secret not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
+		}
+		return
+	}
+
+	// States from before the salt was introduced get a salt, unviewable secrets then show up as changes once
+	if data.HashSalt.IsNull() {
+		data.HashSalt = types.StringValue(r.secretHashSalt(types.StringValue(secret.SecretName)))
+	}
+
+	// Detect changes made outside of Terraform. An empty hash never matches
+	// the planned hash, so the value gets written again on the next apply.
+	switch {
+	case secret.CanViewSecretValue:
+		data.ValueHash = types.StringValue(utils.HashStringWithSalt(data.HashSalt.ValueString(), secret.SecretValue))
+	case data.UpdateTimestamp.IsNull() || data.UpdateTimestamp.ValueFloat64() != secret.UpdateTimestamp:
+		tflog.Trace(ctx, "Secret value can't be viewed and was updated outside of Terraform")
+		data.ValueHash = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(setSecretResourceModel(ctx, &data, secret)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := loadSecretValue(data.ValueFile, data.ValueEnv)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to load secret value", err.Error())
+		return
+	}
+
+	locationNames, diags := secretLocationNamesFromSet(ctx, data.LocationNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.SecretsClient.UpdateSecret(
+		ctx,
+		data.Id.ValueString(),
+		data.Name.ValueString(),
+		value,
+		secretScopesInput(data.FullDeploymentScope, data.AllBranchDeploymentsScope, data.SpecificBranchDeploymentScope, data.LocalDeploymentScope),
+		locationNames,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setSecretResourceModel(ctx, &data, secret)...)
+	if data.HashSalt.IsUnknown() {
+		data.HashSalt = types.StringValue(r.secretHashSalt(data.Name))
+	}
+	data.ValueHash = types.StringValue(utils.HashStringWithSalt(data.HashSalt.ValueString(), value))
+
+	tflog.Trace(ctx, "updated secret resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SecretsClient.DeleteSecret(ctx, data.Id.ValueString())
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Secret not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret, got error: %s", err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted secret resource with id: %s", data.Id.ValueString()))
+}
+
+func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// secretHashSalt returns the salt of the value hash of a secret
+func (r *SecretResource) secretHashSalt(name types.String) string {
+	return r.client.HashSalt("secret/" + name.ValueString())
+}
+
+// loadSecretValue reads the secret value from either a file or an environment variable
+func loadSecretValue(valueFile types.String, valueEnv types.String) (string, error) {
+	switch {
+	case !valueFile.IsNull():
+		content, err := os.ReadFile(valueFile.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("unable to read secret value from file %s: %w", valueFile.ValueString(), err)
+		}
+		return string(content), nil
+	case !valueEnv.IsNull():
+		value, ok := os.LookupEnv(valueEnv.ValueString())
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", valueEnv.ValueString())
+		}
+		return value, nil
+	default:
+		return "", errors.New("one of value_file or value_env must be set")
+	}
+}
+
+func fileExists(name string) bool {
+	_, err := os.Stat(name)
+	return !errors.Is(err, os.ErrNotExist)
+}
+
+func secretScopesInput(fullDeployment types.Bool, allBranchDeployments types.Bool, specificBranchDeployment types.String, localDeployment types.Bool) clientSchema.SecretScopesInput {
+	scopes := clientSchema.SecretScopesInput{
+		FullDeploymentScope:       fullDeployment.ValueBool(),
+		AllBranchDeploymentsScope: allBranchDeployments.ValueBool(),
+		LocalDeploymentScope:      localDeployment.ValueBool(),
+	}
+
+	if !specificBranchDeployment.IsNull() {
+		branch := specificBranchDeployment.ValueString()
+		scopes.SpecificBranchDeploymentScope = &branch
+	}
+
+	return scopes
+}
+
+func secretLocationNamesFromSet(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	locationNames := make([]string, 0, len(set.Elements()))
+	diags := set.ElementsAs(ctx, &locationNames, false)
+	return locationNames, diags
+}
+
+// setSecretResourceModel copies the attributes returned by the API into the model, except for the value hash
+func setSecretResourceModel(ctx context.Context, data *SecretResourceModel, secret clientSchema.Secret) diag.Diagnostics {
+	data.Id = types.StringValue(secret.Id)
+	data.Name = types.StringValue(secret.SecretName)
+	data.FullDeploymentScope = types.BoolValue(secret.FullDeploymentScope)
+	data.AllBranchDeploymentsScope = types.BoolValue(secret.AllBranchDeploymentsScope)
+	data.LocalDeploymentScope = types.BoolValue(secret.LocalDeploymentScope)
+	data.UpdateTimestamp = types.Float64Value(secret.UpdateTimestamp)
+
+	if secret.SpecificBranchDeploymentScope != "" {
+		data.SpecificBranchDeploymentScope = types.StringValue(secret.SpecificBranchDeploymentScope)
+	} else {
+		data.SpecificBranchDeploymentScope = types.StringNull()
+	}
+
+	locationNames, diags := types.SetValueFrom(ctx, types.StringType, secret.LocationNames)
+	data.LocationNames = locationNames
+
+	return diags
+}
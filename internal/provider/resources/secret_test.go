@@ -0,0 +1,85 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccResourceSecretConfig(name string, allBranchDeployments bool) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_secret" "test" {
+  name                         = "%s"
+  value_env                    = "TF_ACC_DAGSTER_SECRET_VALUE"
+  all_branch_deployments_scope = %t
+}
+`, name, allBranchDeployments)
+}
+
+func TestAccResource_secret_basic(t *testing.T) {
+	secretName := "TAR_SECRET_" + acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	secretValue := acctest.RandString(16)
+	updatedSecretValue := acctest.RandString(16)
+
+	var secretId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create secret
+			{
+				PreConfig: func() { t.Setenv("TF_ACC_DAGSTER_SECRET_VALUE", secretValue) },
+				Config:    testAccResourceSecretConfig(secretName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_secret.test", "name", secretName),
+					testSecretHash(secretValue),
+					resource.TestCheckResourceAttr("dagster_secret.test", "full_deployment_scope", "true"),
+					resource.TestCheckResourceAttr("dagster_secret.test", "all_branch_deployments_scope", "false"),
+					testutils.FetchValueFromState("dagster_secret.test", "id", &secretId),
+				),
+			},
+			// Update value and scopes, should have same id as initial create
+			{
+				PreConfig: func() { t.Setenv("TF_ACC_DAGSTER_SECRET_VALUE", updatedSecretValue) },
+				Config:    testAccResourceSecretConfig(secretName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testSecretHash(updatedSecretValue),
+					resource.TestCheckResourceAttr("dagster_secret.test", "all_branch_deployments_scope", "true"),
+					resource.TestCheckResourceAttrPtr("dagster_secret.test", "id", &secretId),
+				),
+			},
+			// Import by name
+			{
+				ResourceName:                         "dagster_secret.test",
+				ImportState:                          true,
+				ImportStateId:                        secretName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"value_env", "value_hash", "update_timestamp"},
+			},
+		},
+	})
+}
+
+func testSecretHash(value string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		secret, ok := state.RootModule().Resources["dagster_secret.test"]
+		if !ok {
+			return fmt.Errorf("dagster_secret.test not found in state")
+		}
+
+		attributes := secret.Primary.Attributes
+		expected := utils.HashStringWithSalt(attributes["hash_salt"], value)
+		if attributes["value_hash"] != expected {
+			return fmt.Errorf("expected value_hash to be %s, got: %s", expected, attributes["value_hash"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,359 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &SecretsResource{}
+	_ resource.ResourceWithModifyPlan = &SecretsResource{}
+)
+
+// secretsHashSaltKey is the key the salt of `secret_hashes` is derived from
+const secretsHashSaltKey = "secrets"
+
+func NewSecretsResource() resource.Resource {
+	return &SecretsResource{}
+}
+
+type SecretsResource struct {
+	client client.DagsterClient
+}
+
+type SecretsResourceModel struct {
+	Id                            types.String `tfsdk:"id"`
+	Secrets                       types.Map    `tfsdk:"secrets"`
+	SecretHashes                  types.Map    `tfsdk:"secret_hashes"`
+	HashSalt                      types.String `tfsdk:"hash_salt"`
+	FullDeploymentScope           types.Bool   `tfsdk:"full_deployment_scope"`
+	AllBranchDeploymentsScope     types.Bool   `tfsdk:"all_branch_deployments_scope"`
+	SpecificBranchDeploymentScope types.String `tfsdk:"specific_branch_deployment_scope"`
+	LocalDeploymentScope          types.Bool   `tfsdk:"local_deployment_scope"`
+	LocationNames                 types.Set    `tfsdk:"location_names"`
+}
+
+func (r *SecretsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+}
+
+func (r *SecretsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages all secrets (environment variables) in the deployment the provider is configured with. " +
+			"Secrets that are not part of `secrets` are removed from the deployment. Do not combine this resource with `dagster_secret` resources in the same deployment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the deployment the secrets are synced to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secrets": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Map of secret name to secret value. The values are stored in the state as sensitive values, use `dagster_secret` if the values must not be stored in the state.",
+			},
+			"secret_hashes": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				MarkdownDescription: "Map of secret name to the HMAC-SHA-256 of its value, keyed by `hash_salt`. Shows which secrets are added, changed or removed in the plan, without revealing their values. " +
+					"Secrets outside of the configured scopes that share a name with another secret are keyed by `name#id`.",
+			},
+			"hash_salt": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Salt of the hashes in `secret_hashes`, derived from the API token when the resource is created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"full_deployment_scope": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Make the secrets available in the full deployment. DEFAULT `true`",
+			},
+			"all_branch_deployments_scope": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Make the secrets available in all branch deployments. DEFAULT `false`",
+			},
+			"specific_branch_deployment_scope": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Make the secrets available in the branch deployment of this git branch only",
+			},
+			"local_deployment_scope": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Make the secrets available in local development. DEFAULT `false`",
+			},
+			"location_names": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Code locations the secrets are available to. An empty set makes the secrets available to all code locations. DEFAULT `[]`",
+			},
+		},
+	}
+}
+
+func (r *SecretsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan computes the hashes of the secret values, so that the plan shows changes per secret
+func (r *SecretsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SecretsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The salt is only derived when the resource is created, so the hashes are known in its first plan as well
+	if plan.HashSalt.IsUnknown() {
+		plan.HashSalt = types.StringValue(r.client.HashSalt(secretsHashSaltKey))
+	}
+
+	if plan.Secrets.IsUnknown() {
+		plan.SecretHashes = types.MapUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	hashes := make(map[string]attr.Value, len(plan.Secrets.Elements()))
+	for name, value := range plan.Secrets.Elements() {
+		valueString := value.(types.String)
+		if valueString.IsUnknown() {
+			hashes[name] = types.StringUnknown()
+		} else {
+			hashes[name] = types.StringValue(utils.HashStringWithSalt(plan.HashSalt.ValueString(), valueString.ValueString()))
+		}
+	}
+
+	secretHashes, diags := types.MapValue(types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.SecretHashes = secretHashes
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *SecretsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecretsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("synced %d secrets", len(data.Secrets.Elements())))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecretsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, err := r.client.SecretsClient.ListSecrets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secrets, got error: %s", err))
+		return
+	}
+
+	locationNames, diags := secretLocationNamesFromSet(ctx, data.LocationNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// States from before the salt was introduced get a salt, unviewable secrets then show up as changes once
+	if data.HashSalt.IsNull() {
+		data.HashSalt = types.StringValue(r.client.HashSalt(secretsHashSaltKey))
+	}
+
+	nameCounts := make(map[string]int, len(secrets))
+	for _, secret := range secrets {
+		nameCounts[secret.SecretName]++
+	}
+
+	stateHashes := data.SecretHashes.Elements()
+	hashes := make(map[string]attr.Value, len(secrets))
+
+	// Secrets added outside of Terraform show up as removals in the plan. Secrets of which
+	// the value or scopes were changed get an empty hash, which shows up as a change.
+	for _, secret := range secrets {
+		inScope := secret.FullDeploymentScope == data.FullDeploymentScope.ValueBool() &&
+			secret.AllBranchDeploymentsScope == data.AllBranchDeploymentsScope.ValueBool() &&
+			secret.SpecificBranchDeploymentScope == data.SpecificBranchDeploymentScope.ValueString() &&
+			secret.LocalDeploymentScope == data.LocalDeploymentScope.ValueBool() &&
+			utils.HasSameElements(secret.LocationNames, locationNames)
+
+		// Secrets sharing a name in other scopes are tracked separately, so they show up as removals
+		key := secret.SecretName
+		if nameCounts[secret.SecretName] > 1 && !inScope {
+			key = secret.SecretName + "#" + secret.Id
+		}
+
+		var hash types.String
+		switch {
+		case !inScope:
+			hash = types.StringValue("")
+		case secret.CanViewSecretValue:
+			hash = types.StringValue(utils.HashStringWithSalt(data.HashSalt.ValueString(), secret.SecretValue))
+		case stateHashes[key] != nil:
+			// The value can't be viewed, assume it is unchanged
+			hash = stateHashes[key].(types.String)
+		default:
+			hash = types.StringValue("")
+		}
+		hashes[key] = hash
+	}
+
+	secretHashes, diags := types.MapValue(types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(r.client.Deployment)
+	data.SecretHashes = secretHashes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SecretsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("synced %d secrets", len(data.Secrets.Elements())))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SecretsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SecretsClient.SyncSecrets(ctx, []clientSchema.SecretInput{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secrets, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted all secrets of deployment %s", data.Id.ValueString()))
+}
+
+// sync replaces all secrets of the deployment with the planned secrets and updates the hashes in the model
+func (r *SecretsResource) sync(ctx context.Context, data *SecretsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	values := make(map[string]string, len(data.Secrets.Elements()))
+	diags.Append(data.Secrets.ElementsAs(ctx, &values, false)...)
+
+	locationNames, locationDiags := secretLocationNamesFromSet(ctx, data.LocationNames)
+	diags.Append(locationDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// The salt is kept for the lifetime of the resource
+	if data.HashSalt.IsUnknown() || data.HashSalt.IsNull() {
+		data.HashSalt = types.StringValue(r.client.HashSalt(secretsHashSaltKey))
+	}
+
+	scopes := secretScopesInput(data.FullDeploymentScope, data.AllBranchDeploymentsScope, data.SpecificBranchDeploymentScope, data.LocalDeploymentScope)
+
+	secretInputs := make([]clientSchema.SecretInput, 0, len(values))
+	hashes := make(map[string]attr.Value, len(values))
+	for name, value := range values {
+		secretInputs = append(secretInputs, clientSchema.SecretInput{
+			Scopes:        scopes,
+			SecretName:    name,
+			SecretValue:   value,
+			LocationNames: locationNames,
+		})
+		hashes[name] = types.StringValue(utils.HashStringWithSalt(data.HashSalt.ValueString(), value))
+	}
+
+	_, err := r.client.SecretsClient.SyncSecrets(ctx, secretInputs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to sync secrets, got error: %s", err))
+		return diags
+	}
+
+	data.Id = types.StringValue(r.client.Deployment)
+	data.SecretHashes = types.MapValueMust(types.StringType, hashes)
+
+	return diags
+}
@@ -0,0 +1,81 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/datarootsio/terraform-provider-dagster/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func testAccResourceSecretsConfig(secrets string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_secrets" "test" {
+  secrets = %s
+}
+`, secrets)
+}
+
+func TestAccResource_secrets_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create secrets
+			{
+				Config: testAccResourceSecretsConfig(`{
+    TAR_SECRETS_1 = "value_1"
+    TAR_SECRETS_2 = "value_2"
+  }`),
+				// The hashes are already known when the resource is created
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("dagster_secrets.test", tfjsonpath.New("secret_hashes"), knownvalue.MapSizeExact(2)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_secrets.test", "secret_hashes.%", "2"),
+					resource.TestCheckResourceAttrSet("dagster_secrets.test", "hash_salt"),
+					testSecretsHash("TAR_SECRETS_1", "value_1"),
+					testSecretsHash("TAR_SECRETS_2", "value_2"),
+				),
+			},
+			// Change one secret, remove another and add a new one
+			{
+				Config: testAccResourceSecretsConfig(`{
+    TAR_SECRETS_1 = "value_1_updated"
+    TAR_SECRETS_3 = "value_3"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_secrets.test", "secret_hashes.%", "2"),
+					testSecretsHash("TAR_SECRETS_1", "value_1_updated"),
+					resource.TestCheckNoResourceAttr("dagster_secrets.test", "secret_hashes.TAR_SECRETS_2"),
+					testSecretsHash("TAR_SECRETS_3", "value_3"),
+				),
+			},
+		},
+	})
+}
+
+// testSecretsHash checks the hash of a secret against the salt in state
+func testSecretsHash(name string, value string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		secrets, ok := state.RootModule().Resources["dagster_secrets.test"]
+		if !ok {
+			return fmt.Errorf("dagster_secrets.test not found in state")
+		}
+
+		attributes := secrets.Primary.Attributes
+		expected := utils.HashStringWithSalt(attributes["hash_salt"], value)
+		if attributes["secret_hashes."+name] != expected {
+			return fmt.Errorf("expected hash of secret %s to be %s, got: %s", name, expected, attributes["secret_hashes."+name])
+		}
+
+		return nil
+	}
+}
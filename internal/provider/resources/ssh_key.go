@@ -0,0 +1,247 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &SSHKeyResource{}
+	_ resource.ResourceWithImportState = &SSHKeyResource{}
+)
+
+func NewSSHKeyResource() resource.Resource {
+	return &SSHKeyResource{}
+}
+
+type SSHKeyResource struct {
+	client client.DagsterClient
+}
+
+type SSHKeyResourceModel struct {
+	Id              types.Int64   `tfsdk:"id"`
+	UserId          types.Int64   `tfsdk:"user_id"`
+	PublicKey       types.String  `tfsdk:"public_key"`
+	Name            types.String  `tfsdk:"name"`
+	CreateTimestamp types.Float64 `tfsdk:"create_timestamp"`
+}
+
+func (r *SSHKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (r *SSHKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Registers a public SSH key for a user. SSH keys can't be changed, every change revokes the key and registers a new one.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "SSH key id",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the user the SSH key belongs to, for example `dagster_user.id`",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Public SSH key, for example the content of `~/.ssh/id_ed25519.pub`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "SSH key name, defaults to the name Dagster Cloud derives from the key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_timestamp": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the registration of the SSH key",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SSHKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validation
+	_, err := r.client.UsersClient.GetUserById(ctx, data.UserId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SSH key, got error: %s", err))
+		return
+	}
+
+	key, err := r.client.SSHKeysClient.CreateSSHKey(
+		ctx,
+		int(data.UserId.ValueInt64()),
+		data.PublicKey.ValueString(),
+		data.Name.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SSH key, got error: %s", err))
+		return
+	}
+
+	setSSHKeyResourceModel(&data, key)
+
+	tflog.Trace(ctx, fmt.Sprintf("created SSH key with id: %d", key.Id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.SSHKeysClient.GetSSHKeyById(ctx, int(data.UserId.ValueInt64()), int(data.Id.ValueInt64()))
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "SSH key not found, probably already revoked manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SSH key, got error: %s", err))
+		}
+		return
+	}
+
+	setSSHKeyResourceModel(&data, key)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes, as every attribute requires a replacement of the SSH key
+func (r *SSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SSHKeysClient.RevokeSSHKey(ctx, int(data.UserId.ValueInt64()), int(data.Id.ValueInt64()))
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "SSH key not found, probably already revoked manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke SSH key, got error: %s", err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("revoked SSH key with id: %d", data.Id.ValueInt64()))
+}
+
+// ImportState imports an SSH key by `user_id/ssh_key_id`
+func (r *SSHKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userIdString, keyIdString, ok := strings.Cut(req.ID, "/")
+
+	userId, errUserId := strconv.ParseInt(userIdString, 10, 64)
+	keyId, errKeyId := strconv.ParseInt(keyIdString, 10, 64)
+	if !ok || errUserId != nil || errKeyId != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import id",
+			fmt.Sprintf("Expected an import id like user_id/ssh_key_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), keyId)...)
+}
+
+func setSSHKeyResourceModel(data *SSHKeyResourceModel, key clientSchema.SSHKey) {
+	data.Id = types.Int64Value(int64(key.Id))
+	if data.PublicKey.IsNull() || !sshPublicKeysAreEqual(data.PublicKey.ValueString(), key.PublicKey) {
+		data.PublicKey = types.StringValue(key.PublicKey)
+	}
+	data.Name = types.StringValue(key.Name)
+	data.CreateTimestamp = types.Float64Value(key.CreateTimestamp)
+}
+
+// sshPublicKeysAreEqual compares the key type and key of two public SSH keys, ignoring the comment and whitespace
+func sshPublicKeysAreEqual(a string, b string) bool {
+	fieldsA := strings.Fields(a)
+	fieldsB := strings.Fields(b)
+
+	if len(fieldsA) < 2 || len(fieldsB) < 2 {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+
+	return fieldsA[0] == fieldsB[0] && fieldsA[1] == fieldsB[1]
+}
@@ -0,0 +1,68 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccSSHPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEAyXxE+XUbrmGRSZDGzFoIQmwo+o9YAxMY7qClbMmvM terraform-provider-dagster-test"
+
+func testAccResourceSSHKeyConfig(name string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_user" "test" {
+  email                      = "acc-test-user-ssh-key@dataroots.io"
+  remove_default_permissions = true
+}
+
+resource "dagster_ssh_key" "test" {
+  user_id    = dagster_user.test.id
+  public_key = "%s"
+  name       = "%s"
+}
+`, testAccSSHPublicKey, name)
+}
+
+func TestAccResource_sshKey_basic(t *testing.T) {
+	var userId, keyIdBefore, keyIdAfter string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSSHKeyConfig("tf-acc-ssh-key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_ssh_key.test", "name", "tf-acc-ssh-key"),
+					resource.TestCheckResourceAttr("dagster_ssh_key.test", "public_key", testAccSSHPublicKey),
+					resource.TestCheckResourceAttrPair("dagster_ssh_key.test", "user_id", "dagster_user.test", "id"),
+					testutils.FetchValueFromState("dagster_user.test", "id", &userId),
+					testutils.FetchValueFromState("dagster_ssh_key.test", "id", &keyIdBefore),
+				),
+			},
+			// Changing the name registers a new SSH key
+			{
+				Config: testAccResourceSSHKeyConfig("tf-acc-ssh-key-renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_ssh_key.test", "name", "tf-acc-ssh-key-renamed"),
+					testutils.FetchValueFromState("dagster_ssh_key.test", "id", &keyIdAfter),
+					func(_ *terraform.State) error {
+						if keyIdBefore == keyIdAfter {
+							return fmt.Errorf("expected SSH key %s to be replaced", keyIdBefore)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "dagster_ssh_key.test",
+				ImportState:       true,
+				ImportStateIdFunc: func(_ *terraform.State) (string, error) { return userId + "/" + keyIdAfter, nil },
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
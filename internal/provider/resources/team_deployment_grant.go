@@ -4,21 +4,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/datarootsio/terraform-provider-dagster/internal/client"
 	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
 	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.Resource = &TeamDeploymentGrantResource{}
+var (
+	_ resource.Resource                   = &TeamDeploymentGrantResource{}
+	_ resource.ResourceWithValidateConfig = &TeamDeploymentGrantResource{}
+	_ resource.ResourceWithImportState    = &TeamDeploymentGrantResource{}
+)
 
 func NewTeamDeploymentGrantResource() resource.Resource {
 	return &TeamDeploymentGrantResource{}
@@ -29,6 +37,7 @@ type TeamDeploymentGrantResource struct {
 }
 
 type TeamDeploymentGrantResourceModel struct {
+	DeploymentScope    types.String `tfsdk:"deployment_scope"`
 	DeploymentId       types.Int64  `tfsdk:"deployment_id"`
 	TeamId             types.String `tfsdk:"team_id"`
 	Grant              types.String `tfsdk:"grant"`
@@ -42,12 +51,25 @@ func (r *TeamDeploymentGrantResource) Metadata(ctx context.Context, req resource
 
 func (r *TeamDeploymentGrantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Creates a team deployment grants, which grants a team permissions on a deployment and its code locations.",
+		MarkdownDescription: "Creates a team deployment grants, which grants a team permissions on a deployment and its code locations. " +
+			"With `deployment_scope` the team can be granted permissions on the organization or on all branch deployments instead.",
 
 		Attributes: map[string]schema.Attribute{
+			"deployment_scope": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(clientSchema.PermissionDeploymentScopeDeployment)),
+				MarkdownDescription: "Scope of the grant, one of `" + strings.Join(clientTypes.DeploymentScopeEnumValues(), "`, `") + "`. DEFAULT `DEPLOYMENT`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.DeploymentScopeEnumValues()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"deployment_id": schema.Int64Attribute{
-				MarkdownDescription: "Team Deployment Grant DeploymentId",
-				Required:            true,
+				MarkdownDescription: "Team Deployment Grant DeploymentId, required when `deployment_scope` is `DEPLOYMENT`",
+				Optional:            true,
 			},
 			"team_id": schema.StringAttribute{
 				MarkdownDescription: "Team Deployment Grant TeamId",
@@ -76,7 +98,8 @@ func (r *TeamDeploymentGrantResource) Schema(ctx context.Context, req resource.S
 						},
 					},
 				},
-				Optional: true,
+				Optional:            true,
+				MarkdownDescription: "Code location grants, only when `deployment_scope` is `DEPLOYMENT`",
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Team Deployment Grant Id",
@@ -107,6 +130,46 @@ func (r *TeamDeploymentGrantResource) Configure(ctx context.Context, req resourc
 	r.client = client
 }
 
+// ValidateConfig ensures a deployment id and code location grants are only given for the DEPLOYMENT scope
+func (r *TeamDeploymentGrantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamDeploymentGrantResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeploymentScope.IsUnknown() || data.DeploymentId.IsUnknown() {
+		return
+	}
+
+	isDeploymentScope := data.DeploymentScope.IsNull() || data.DeploymentScope.ValueString() == string(clientSchema.PermissionDeploymentScopeDeployment)
+
+	if isDeploymentScope && data.DeploymentId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deployment_id"),
+			"Missing deployment_id",
+			"deployment_id is required when deployment_scope is DEPLOYMENT",
+		)
+	}
+
+	if !isDeploymentScope && !data.DeploymentId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deployment_id"),
+			"Invalid deployment_id",
+			fmt.Sprintf("deployment_id can't be set when deployment_scope is %s", data.DeploymentScope.ValueString()),
+		)
+	}
+
+	if !isDeploymentScope && !data.CodeLocationGrants.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("code_location_grants"),
+			"Invalid code_location_grants",
+			fmt.Sprintf("code_location_grants can't be set when deployment_scope is %s", data.DeploymentScope.ValueString()),
+		)
+	}
+}
+
 func (r *TeamDeploymentGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data TeamDeploymentGrantResourceModel
 
@@ -122,6 +185,12 @@ func (r *TeamDeploymentGrantResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	scope, err := clientTypes.ConvertToDeploymentScopeEnum(data.DeploymentScope.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team deployment grant, got error: %s", err))
+		return
+	}
+
 	codeLocationGrants := make([]clientSchema.LocationScopedGrant, 0)
 
 	if !data.CodeLocationGrants.IsNull() {
@@ -152,10 +221,12 @@ func (r *TeamDeploymentGrantResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	_, err = r.client.DeploymentClient.GetDeploymentById(ctx, int(data.DeploymentId.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team deployment grant, got error: %s", err))
-		return
+	if scope == clientSchema.PermissionDeploymentScopeDeployment {
+		_, err = r.client.DeploymentClient.GetDeploymentById(ctx, int(data.DeploymentId.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team deployment grant, got error: %s", err))
+			return
+		}
 	}
 
 	for _, codeLocationGrant := range codeLocationGrants {
@@ -166,9 +237,10 @@ func (r *TeamDeploymentGrantResource) Create(ctx context.Context, req resource.C
 		}
 	}
 
-	teamDeploymentGrant, err := r.client.TeamsClient.CreateOrUpdateTeamDeploymentGrant(
+	teamDeploymentGrant, err := r.client.TeamsClient.CreateOrUpdateTeamGrant(
 		ctx,
 		data.TeamId.ValueString(),
+		scope,
 		int(data.DeploymentId.ValueInt64()),
 		grantEnum,
 		codeLocationGrants,
@@ -194,9 +266,21 @@ func (r *TeamDeploymentGrantResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	teamDeploymentGrant, err := r.client.TeamsClient.GetTeamDeploymentGrantByTeamAndDeploymentId(
+	// Grants created before deployment_scope was introduced are deployment grants
+	if data.DeploymentScope.IsNull() {
+		data.DeploymentScope = types.StringValue(string(clientSchema.PermissionDeploymentScopeDeployment))
+	}
+
+	scope, err := clientTypes.ConvertToDeploymentScopeEnum(data.DeploymentScope.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team deployment grant, got error: %s", err))
+		return
+	}
+
+	teamDeploymentGrant, err := r.client.TeamsClient.GetTeamGrant(
 		ctx,
 		data.TeamId.ValueString(),
+		scope,
 		int(data.DeploymentId.ValueInt64()),
 	)
 	if err != nil {
@@ -211,6 +295,18 @@ func (r *TeamDeploymentGrantResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	data.Id = types.Int64Value(int64(teamDeploymentGrant.Id))
+	data.Grant = types.StringValue(string(teamDeploymentGrant.Grant))
+
+	// Keep a null set when no code location grants are configured nor granted
+	if !data.CodeLocationGrants.IsNull() || len(teamDeploymentGrant.LocationGrants) > 0 {
+		codeLocationGrants, diags := codeLocationGrantsToSet(teamDeploymentGrant.LocationGrants)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.CodeLocationGrants = codeLocationGrants
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -230,6 +326,12 @@ func (r *TeamDeploymentGrantResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	scope, err := clientTypes.ConvertToDeploymentScopeEnum(data.DeploymentScope.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team deployment grant, got error: %s", err))
+		return
+	}
+
 	codeLocationGrants := make([]clientSchema.LocationScopedGrant, 0)
 
 	if !data.CodeLocationGrants.IsNull() {
@@ -260,10 +362,12 @@ func (r *TeamDeploymentGrantResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	_, err = r.client.DeploymentClient.GetDeploymentById(ctx, int(data.DeploymentId.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team deployment grant, got error: %s", err))
-		return
+	if scope == clientSchema.PermissionDeploymentScopeDeployment {
+		_, err = r.client.DeploymentClient.GetDeploymentById(ctx, int(data.DeploymentId.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team deployment grant, got error: %s", err))
+			return
+		}
 	}
 
 	for _, codeLocationGrant := range codeLocationGrants {
@@ -274,9 +378,10 @@ func (r *TeamDeploymentGrantResource) Update(ctx context.Context, req resource.U
 		}
 	}
 
-	teamDeploymentGrant, err := r.client.TeamsClient.CreateOrUpdateTeamDeploymentGrant(
+	teamDeploymentGrant, err := r.client.TeamsClient.CreateOrUpdateTeamGrant(
 		ctx,
 		data.TeamId.ValueString(),
+		scope,
 		int(data.DeploymentId.ValueInt64()),
 		grantEnum,
 		codeLocationGrants,
@@ -301,22 +406,31 @@ func (r *TeamDeploymentGrantResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	// Validation
-	_, err := r.client.TeamsClient.GetTeamById(ctx, data.TeamId.ValueString())
+	scope, err := clientTypes.ConvertToDeploymentScopeEnum(data.DeploymentScope.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team deployment grant, got error: %s", err))
 		return
 	}
 
-	_, err = r.client.DeploymentClient.GetDeploymentById(ctx, int(data.DeploymentId.ValueInt64()))
+	// Validation
+	_, err = r.client.TeamsClient.GetTeamById(ctx, data.TeamId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team deployment grant, got error: %s", err))
 		return
 	}
 
-	err = r.client.TeamsClient.RemoveTeamDeploymentGrant(
+	if scope == clientSchema.PermissionDeploymentScopeDeployment {
+		_, err = r.client.DeploymentClient.GetDeploymentById(ctx, int(data.DeploymentId.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team deployment grant, got error: %s", err))
+			return
+		}
+	}
+
+	err = r.client.TeamsClient.RemoveTeamGrant(
 		ctx,
 		data.TeamId.ValueString(),
+		scope,
 		int(data.DeploymentId.ValueInt64()),
 	)
 	if err != nil {
@@ -330,5 +444,43 @@ func (r *TeamDeploymentGrantResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	tflog.Trace(ctx, fmt.Sprintf("deleted team deployment grant resource with TeamId=%s DeploymentId=%d", data.TeamId.ValueString(), data.DeploymentId.ValueInt64()))
+	tflog.Trace(ctx, fmt.Sprintf("deleted team deployment grant resource with TeamId=%s DeploymentScope=%s DeploymentId=%d", data.TeamId.ValueString(), data.DeploymentScope.ValueString(), data.DeploymentId.ValueInt64()))
+}
+
+// ImportState imports a grant by `team_name/deployment_name`, or `team_name/deployment_scope` for the other scopes
+func (r *TeamDeploymentGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Team names can contain slashes, deployment names can't
+	separator := strings.LastIndex(req.ID, "/")
+	if separator <= 0 || separator == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Invalid import id",
+			fmt.Sprintf("Expected an import id like team_name/deployment_name or team_name/deployment_scope, got: %s", req.ID),
+		)
+		return
+	}
+	teamName, scopeOrDeploymentName := req.ID[:separator], req.ID[separator+1:]
+
+	team, err := r.client.TeamsClient.GetTeamByName(ctx, teamName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import team deployment grant, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), team.Id)...)
+
+	// Deployment names are lowercase, so they don't clash with the scopes
+	scope, err := clientTypes.ConvertToDeploymentScopeEnum(scopeOrDeploymentName)
+	if err == nil && scope != clientSchema.PermissionDeploymentScopeDeployment && scopeOrDeploymentName == string(scope) {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_scope"), string(scope))...)
+		return
+	}
+
+	deployment, err := r.client.DeploymentClient.GetDeploymentByName(ctx, scopeOrDeploymentName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import team deployment grant, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_scope"), string(clientSchema.PermissionDeploymentScopeDeployment))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), int64(deployment.DeploymentId))...)
 }
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
 	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
 	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -51,6 +53,7 @@ func TestAccResourceBasicTeamDeploymentGrant(t *testing.T) {
 	clName := "code-location-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
 
 	var deploymentId string
+	var deploymentName string
 	var teamId string
 
 	resource.Test(t, resource.TestCase{
@@ -62,6 +65,7 @@ func TestAccResourceBasicTeamDeploymentGrant(t *testing.T) {
 				Config: testAccResourceTeamDeploymentGrantConfig(teamName, clName, "VIEWER", "LAUNCHER"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testutils.FetchValueFromState("data.dagster_current_deployment.current", "id", &deploymentId),
+					testutils.FetchValueFromState("data.dagster_current_deployment.current", "name", &deploymentName),
 					testutils.FetchValueFromState("dagster_team.this", "id", &teamId),
 					testGrantProperties(&teamId, &deploymentId, "VIEWER"),
 					resource.TestCheckResourceAttrPtr("dagster_team_deployment_grant.test", "deployment_id", &deploymentId),
@@ -80,6 +84,98 @@ func TestAccResourceBasicTeamDeploymentGrant(t *testing.T) {
 					resource.TestCheckResourceAttr("dagster_team_deployment_grant.test", "grant", "VIEWER"),
 				),
 			},
+			// Import by team_name/deployment_name
+			{
+				ResourceName:      "dagster_team_deployment_grant.test",
+				ImportState:       true,
+				ImportStateIdFunc: func(_ *terraform.State) (string, error) { return teamName + "/" + deploymentName, nil },
+				ImportStateVerify: true,
+			},
+			// Grant changed outside of terraform is detected and reverted
+			{
+				PreConfig: func() {
+					client := testutils.GetDagsterClientFromEnvVars()
+					deploymentIdInt, err := strconv.Atoi(deploymentId)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					_, err = client.TeamsClient.CreateOrUpdateTeamGrant(
+						context.Background(),
+						teamId,
+						clientSchema.PermissionDeploymentScopeDeployment,
+						deploymentIdInt,
+						clientSchema.PermissionGrantLauncher,
+						[]clientSchema.LocationScopedGrant{{LocationName: clName, Grant: clientSchema.PermissionGrantEditor}},
+					)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccResourceTeamDeploymentGrantConfig(teamName, clName, "VIEWER", "EDITOR"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceTeamDeploymentGrantConfig(teamName, clName, "VIEWER", "EDITOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testGrantProperties(&teamId, &deploymentId, "VIEWER"),
+					resource.TestCheckResourceAttr("dagster_team_deployment_grant.test", "grant", "VIEWER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceTeamScopedGrantConfig(teamName string, scope string, grant string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_team" "this" {
+  name = "%s"
+}
+
+resource "dagster_team_deployment_grant" "test" {
+  team_id          = dagster_team.this.id
+  deployment_scope = "%s"
+  grant            = "%s"
+}
+`, teamName, scope, grant)
+}
+
+func TestAccResourceTeamScopedGrant(t *testing.T) {
+	teamName := "team-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A deployment id is required in the DEPLOYMENT scope
+			{
+				Config:      testAccResourceTeamScopedGrantConfig(teamName, "DEPLOYMENT", "VIEWER"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`deployment_id is required when deployment_scope is DEPLOYMENT`),
+			},
+			{
+				Config: testAccResourceTeamScopedGrantConfig(teamName, "ALL_BRANCH_DEPLOYMENTS", "LAUNCHER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_team_deployment_grant.test", "deployment_scope", "ALL_BRANCH_DEPLOYMENTS"),
+					resource.TestCheckResourceAttr("dagster_team_deployment_grant.test", "grant", "LAUNCHER"),
+					resource.TestCheckNoResourceAttr("dagster_team_deployment_grant.test", "deployment_id"),
+				),
+			},
+			{
+				Config: testAccResourceTeamScopedGrantConfig(teamName, "ORGANIZATION", "ADMIN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_team_deployment_grant.test", "deployment_scope", "ORGANIZATION"),
+					resource.TestCheckResourceAttr("dagster_team_deployment_grant.test", "grant", "ADMIN"),
+				),
+			},
+			// Import by team_name/deployment_scope
+			{
+				ResourceName:      "dagster_team_deployment_grant.test",
+				ImportState:       true,
+				ImportStateId:     teamName + "/ORGANIZATION",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,400 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &TeamDeploymentGrantsResource{}
+	_ resource.ResourceWithModifyPlan  = &TeamDeploymentGrantsResource{}
+	_ resource.ResourceWithImportState = &TeamDeploymentGrantsResource{}
+)
+
+func NewTeamDeploymentGrantsResource() resource.Resource {
+	return &TeamDeploymentGrantsResource{}
+}
+
+type TeamDeploymentGrantsResource struct {
+	client client.DagsterClient
+}
+
+type TeamDeploymentGrantsResourceModel struct {
+	TeamId           types.String `tfsdk:"team_id"`
+	DeploymentGrants types.Map    `tfsdk:"deployment_grants"`
+}
+
+type teamDeploymentGrantsElementModel struct {
+	Grant              types.String `tfsdk:"grant"`
+	CodeLocationGrants types.Set    `tfsdk:"code_location_grants"`
+}
+
+func (r *TeamDeploymentGrantsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_deployment_grants"
+}
+
+func (r *TeamDeploymentGrantsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the grants of a team on all deployments. Grants on deployments that are not part of `deployment_grants` are removed, " +
+			"also when they are created outside of Terraform. Do not combine this resource with `dagster_team_deployment_grant` resources in the `DEPLOYMENT` scope for the same team.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Team id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployment_grants": schema.MapNestedAttribute{
+				Required: true,
+				MarkdownDescription: "Map of deployment name to the grant of the team on that deployment. " +
+					"Grants on deployments that can't be listed by name, like branch deployments, are keyed by deployment id. Deployments that have a name can't be keyed by id.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"grant": schema.StringAttribute{
+							MarkdownDescription: "Grant of the team on the deployment",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(clientTypes.DeploymentGrantEnumValues()...),
+							},
+						},
+						"code_location_grants": schema.SetNestedAttribute{
+							MarkdownDescription: "Grants of the team on code locations of the deployment, these can't be less permissive than `grant`",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Code location Name",
+										Required:            true,
+									},
+									"grant": schema.StringAttribute{
+										MarkdownDescription: "Code location Grant",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(clientTypes.LocationGrantEnumValues()...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamDeploymentGrantsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks that every key of `deployment_grants` resolves to a deployment the same way Read keys it
+func (r *TeamDeploymentGrantsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TeamDeploymentGrantsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.DeploymentGrants.IsUnknown() {
+		return
+	}
+
+	keys := make([]string, 0, len(plan.DeploymentGrants.Elements()))
+	for key := range plan.DeploymentGrants.Elements() {
+		keys = append(keys, key)
+	}
+
+	_, diags := r.resolveDeploymentIds(ctx, keys)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TeamDeploymentGrantsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamDeploymentGrantsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setTeamDeploymentGrants(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("set %d deployment grants of team %s", len(data.DeploymentGrants.Elements()), data.TeamId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamDeploymentGrantsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamDeploymentGrantsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grants, err := r.client.TeamsClient.ListTeamDeploymentGrants(ctx, data.TeamId.ValueString())
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Team not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment grants of team %s, got error: %s", data.TeamId.ValueString(), err))
+		}
+		return
+	}
+
+	deployments, err := r.client.DeploymentClient.GetAllDeployments(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployments, got error: %s", err))
+		return
+	}
+
+	deploymentNames := make(map[int]string, len(deployments))
+	for _, deployment := range deployments {
+		deploymentNames[deployment.DeploymentId] = deployment.DeploymentName
+	}
+
+	priorElements := make(map[string]teamDeploymentGrantsElementModel)
+	resp.Diagnostics.Append(data.DeploymentGrants.ElementsAs(ctx, &priorElements, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All grants are tracked, so grants created outside of Terraform show up as drift
+	elements := make(map[string]teamDeploymentGrantsElementModel, len(grants))
+	for _, grant := range grants {
+		key, ok := deploymentNames[grant.DeploymentId]
+		if !ok {
+			key = strconv.Itoa(grant.DeploymentId)
+		}
+
+		element := teamDeploymentGrantsElementModel{
+			Grant:              types.StringValue(string(grant.Grant)),
+			CodeLocationGrants: types.SetNull(types.ObjectType{AttrTypes: codeLocationGrantAttributeTypes}),
+		}
+
+		// Keep a null set when no code location grants are configured nor granted
+		if !priorElements[key].CodeLocationGrants.IsNull() || len(grant.LocationGrants) > 0 {
+			codeLocationGrants, diags := codeLocationGrantsToSet(grant.LocationGrants)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			element.CodeLocationGrants = codeLocationGrants
+		}
+
+		elements[key] = element
+	}
+
+	deploymentGrants, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: teamDeploymentGrantsElementAttributeTypes()}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.DeploymentGrants = deploymentGrants
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamDeploymentGrantsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamDeploymentGrantsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setTeamDeploymentGrants(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("set %d deployment grants of team %s", len(data.DeploymentGrants.Elements()), data.TeamId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamDeploymentGrantsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamDeploymentGrantsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.TeamsClient.SetTeamDeploymentGrants(ctx, data.TeamId.ValueString(), map[int]clientTypes.DeploymentGrant{})
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Team not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove deployment grants of team %s, got error: %s", data.TeamId.ValueString(), err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("removed all deployment grants of team %s", data.TeamId.ValueString()))
+}
+
+// setTeamDeploymentGrants resolves the deployment names and reconciles the grants of the team
+// ImportState imports all deployment grants of a team by team name
+func (r *TeamDeploymentGrantsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	team, err := r.client.TeamsClient.GetTeamByName(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import team deployment grants, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), team.Id)...)
+}
+
+func (r *TeamDeploymentGrantsResource) setTeamDeploymentGrants(ctx context.Context, data TeamDeploymentGrantsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elements := make(map[string]teamDeploymentGrantsElementModel)
+	diags.Append(data.DeploymentGrants.ElementsAs(ctx, &elements, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+
+	deploymentIds, resolveDiags := r.resolveDeploymentIds(ctx, keys)
+	diags.Append(resolveDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	grants := make(map[int]clientTypes.DeploymentGrant, len(elements))
+	for key, element := range elements {
+		deploymentId := deploymentIds[key]
+
+		grant, err := clientTypes.ConvertToGrantEnum(element.Grant.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set deployment grants of team %s, got error: %s", data.TeamId.ValueString(), err))
+			return diags
+		}
+
+		codeLocationGrants, err := codeLocationGrantsFromSet(element.CodeLocationGrants)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set deployment grants of team %s, got error: %s", data.TeamId.ValueString(), err))
+			return diags
+		}
+
+		grants[deploymentId] = clientTypes.DeploymentGrant{
+			Grant:          grant,
+			LocationGrants: codeLocationGrants,
+		}
+	}
+
+	err := r.client.TeamsClient.SetTeamDeploymentGrants(ctx, data.TeamId.ValueString(), grants)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set deployment grants of team %s, got error: %s", data.TeamId.ValueString(), err))
+	}
+
+	return diags
+}
+
+// resolveDeploymentIds resolves the keys of `deployment_grants` to deployment ids. Keys are deployment names, or ids
+// of deployments without a name in the list of deployments, which is how Read keys the grants.
+func (r *TeamDeploymentGrantsResource) resolveDeploymentIds(ctx context.Context, keys []string) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	deployments, err := r.client.DeploymentClient.GetAllDeployments(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read deployments, got error: %s", err))
+		return nil, diags
+	}
+
+	deploymentIds := make(map[string]int, len(deployments))
+	deploymentNames := make(map[int]string, len(deployments))
+	for _, deployment := range deployments {
+		deploymentIds[deployment.DeploymentName] = deployment.DeploymentId
+		deploymentNames[deployment.DeploymentId] = deployment.DeploymentName
+	}
+
+	result := make(map[string]int, len(keys))
+	for _, key := range keys {
+		if deploymentId, ok := deploymentIds[key]; ok {
+			result[key] = deploymentId
+			continue
+		}
+
+		deploymentId, err := strconv.Atoi(key)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("deployment_grants").AtMapKey(key),
+				"Unknown deployment",
+				fmt.Sprintf("Deployment %s does not exist", key),
+			)
+			continue
+		}
+
+		if name, ok := deploymentNames[deploymentId]; ok {
+			diags.AddAttributeError(
+				path.Root("deployment_grants").AtMapKey(key),
+				"Deployment keyed by id",
+				fmt.Sprintf("Deployment %d has a name, use %s as key instead", deploymentId, name),
+			)
+			continue
+		}
+
+		result[key] = deploymentId
+	}
+
+	return result, diags
+}
+
+func teamDeploymentGrantsElementAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"grant":                types.StringType,
+		"code_location_grants": types.SetType{ElemType: types.ObjectType{AttrTypes: codeLocationGrantAttributeTypes}},
+	}
+}
@@ -0,0 +1,80 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccResourceTeamDeploymentGrantsConfig(teamName string, grant string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+data "dagster_current_deployment" "current" {}
+
+resource "dagster_team" "this" {
+  name = "%s"
+}
+
+resource "dagster_team_deployment_grants" "this" {
+  team_id = dagster_team.this.id
+
+  deployment_grants = {
+    (data.dagster_current_deployment.current.name) = {
+      grant = "%s"
+    }
+  }
+}
+`, teamName, grant)
+}
+
+func TestAccResourceTeamDeploymentGrantsBasic(t *testing.T) {
+	teamName := "team-deployment-grants-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	var deploymentName string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTeamDeploymentGrantsConfig(teamName, "VIEWER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.FetchValueFromState("data.dagster_current_deployment.current", "name", &deploymentName),
+					resource.TestCheckResourceAttr("dagster_team_deployment_grants.this", "deployment_grants.%", "1"),
+				),
+			},
+			{
+				Config: testAccResourceTeamDeploymentGrantsConfig(teamName, "EDITOR"),
+				Check: func(s *terraform.State) error {
+					return resource.TestCheckResourceAttr(
+						"dagster_team_deployment_grants.this",
+						fmt.Sprintf("deployment_grants.%s.grant", deploymentName),
+						"EDITOR",
+					)(s)
+				},
+			},
+			{
+				Config: strings.Replace(
+					testAccResourceTeamDeploymentGrantsConfig(teamName, "EDITOR"),
+					"(data.dagster_current_deployment.current.name)",
+					"(tostring(data.dagster_current_deployment.current.id))",
+					1,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Deployment keyed by id"),
+			},
+			// Import by team name
+			{
+				ResourceName:                         "dagster_team_deployment_grants.this",
+				ImportState:                          true,
+				ImportStateId:                        teamName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+			},
+		},
+	})
+}
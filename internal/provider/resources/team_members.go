@@ -0,0 +1,285 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &TeamMembersResource{}
+	_ resource.ResourceWithConfigValidators = &TeamMembersResource{}
+)
+
+func NewTeamMembersResource() resource.Resource {
+	return &TeamMembersResource{}
+}
+
+type TeamMembersResource struct {
+	client client.DagsterClient
+}
+
+type TeamMembersResourceModel struct {
+	TeamId     types.String `tfsdk:"team_id"`
+	UserIds    types.Set    `tfsdk:"user_ids"`
+	UserEmails types.Set    `tfsdk:"user_emails"`
+}
+
+func (r *TeamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *TeamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages all members of a team. Users that are added to the team outside of Terraform show up as drift and are removed on the next apply. " +
+			"Destroying this resource removes all members from the team. Do not combine this resource with `dagster_team_membership` resources for the same team.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Team id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				MarkdownDescription: "Ids of all users in the team, conflicts with `user_emails`",
+			},
+			"user_emails": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Email addresses of all users in the team, matched case-insensitively, conflicts with `user_ids`",
+			},
+		},
+	}
+}
+
+func (r *TeamMembersResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_ids"),
+			path.MatchRoot("user_emails"),
+		),
+	}
+}
+
+func (r *TeamMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIds, diags := r.resolveUserIds(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.TeamsClient.SetTeamMembers(ctx, data.TeamId.ValueString(), userIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set members of team %s, got error: %s", data.TeamId.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("set %d members of team %s", len(userIds), data.TeamId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := r.client.TeamsClient.GetTeamById(ctx, data.TeamId.ValueString())
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Team not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read members of team %s, got error: %s", data.TeamId.ValueString(), err))
+		}
+		return
+	}
+
+	// All members are tracked, so members added outside of Terraform show up as drift
+	var diags diag.Diagnostics
+	if data.UserEmails.IsNull() {
+		userIds := make([]int64, 0, len(team.Members))
+		for _, member := range team.Members {
+			userIds = append(userIds, int64(member.UserId))
+		}
+		data.UserIds, diags = types.SetValueFrom(ctx, types.Int64Type, userIds)
+	} else {
+		var stateEmails []string
+		resp.Diagnostics.Append(data.UserEmails.ElementsAs(ctx, &stateEmails, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Emails are compared case-insensitively, so the spelling in the state is kept
+		userEmails := make([]string, 0, len(team.Members))
+		for _, member := range team.Members {
+			email := member.Email
+			for _, stateEmail := range stateEmails {
+				if strings.EqualFold(stateEmail, email) {
+					email = stateEmail
+					break
+				}
+			}
+			userEmails = append(userEmails, email)
+		}
+		data.UserEmails, diags = types.SetValueFrom(ctx, types.StringType, userEmails)
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIds, diags := r.resolveUserIds(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.TeamsClient.SetTeamMembers(ctx, data.TeamId.ValueString(), userIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set members of team %s, got error: %s", data.TeamId.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("set %d members of team %s", len(userIds), data.TeamId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.TeamsClient.SetTeamMembers(ctx, data.TeamId.ValueString(), []int{})
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "Team not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove members of team %s, got error: %s", data.TeamId.ValueString(), err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("removed all members of team %s", data.TeamId.ValueString()))
+}
+
+// resolveUserIds returns the ids of the declared users, looking up the ids of email addresses
+func (r *TeamMembersResource) resolveUserIds(ctx context.Context, data TeamMembersResourceModel) ([]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.UserIds.IsNull() {
+		var userIds []int64
+		diags.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+		result := make([]int, 0, len(userIds))
+		for _, userId := range userIds {
+			result = append(result, int(userId))
+		}
+		return result, diags
+	}
+
+	var userEmails []string
+	diags.Append(data.UserEmails.ElementsAs(ctx, &userEmails, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	users, err := r.client.UsersClient.GetUsers(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read users, got error: %s", err))
+		return nil, diags
+	}
+
+	result := make([]int, 0, len(userEmails))
+	for _, userEmail := range userEmails {
+		user, ok := findUserByEmail(users, userEmail)
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("user_emails"),
+				"Unknown user",
+				fmt.Sprintf("User with email %s does not exist", userEmail),
+			)
+			continue
+		}
+		result = append(result, user.UserId)
+	}
+
+	return result, diags
+}
+
+func findUserByEmail(users []clientSchema.User, email string) (clientSchema.User, bool) {
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return user, true
+		}
+	}
+
+	return clientSchema.User{}, false
+}
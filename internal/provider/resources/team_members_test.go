@@ -0,0 +1,87 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccResourceTeamMembersConfig(userEmail string, teamName string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_user" "this" {
+  email                      = "%s"
+  remove_default_permissions = true
+}
+
+resource "dagster_team" "this" {
+  name = "%s"
+}
+
+resource "dagster_team_members" "this" {
+  team_id     = dagster_team.this.id
+  user_emails = [dagster_user.this.email]
+}
+`, userEmail, teamName)
+}
+
+func TestAccResourceTeamMembersBasic(t *testing.T) {
+	teamName := "team-members-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	userEmail := "acc-test-team-members-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum) + "@dataroots.io"
+	var teamId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTeamMembersConfig(userEmail, teamName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.FetchValueFromState("dagster_team.this", "id", &teamId),
+					resource.TestCheckResourceAttr("dagster_team_members.this", "user_emails.#", "1"),
+					resource.TestCheckTypeSetElemAttr("dagster_team_members.this", "user_emails.*", userEmail),
+				),
+			},
+			// Members added outside of Terraform show up as drift
+			{
+				PreConfig: func() {
+					client := testutils.GetDagsterClientFromEnvVars()
+					user, err := client.UsersClient.GetUserByEmail(context.Background(), "test-user@dataroots.io")
+					if err != nil {
+						t.Fatal(err)
+					}
+					err = client.TeamsClient.AddUserToTeam(context.Background(), user.UserId, teamId)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccResourceTeamMembersConfig(userEmail, teamName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Applying removes the unmanaged member again
+			{
+				Config: testAccResourceTeamMembersConfig(userEmail, teamName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_team_members.this", "user_emails.#", "1"),
+				),
+			},
+			// Emails are matched case-insensitively, the configured spelling doesn't show up as drift
+			{
+				Config: strings.Replace(
+					testAccResourceTeamMembersConfig(userEmail, teamName),
+					"[dagster_user.this.email]",
+					"[upper(dagster_user.this.email)]",
+					1,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("dagster_team_members.this", "user_emails.*", strings.ToUpper(userEmail)),
+				),
+			},
+		},
+	})
+}
@@ -3,8 +3,10 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -14,7 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.Resource = &UserResource{}
+var (
+	_ resource.Resource                = &TeamMembershipResource{}
+	_ resource.ResourceWithImportState = &TeamMembershipResource{}
+)
 
 func NewTeamMembershipResource() resource.Resource {
 	return &TeamMembershipResource{}
@@ -181,3 +186,32 @@ func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.Delete
 
 	tflog.Trace(ctx, fmt.Sprintf("Removed user %v from team %s", data.UserId.ValueInt64(), data.TeamId.ValueString()))
 }
+
+// ImportState imports a team membership by `team_name/user_email`
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Team names can contain slashes, email addresses can't
+	separator := strings.LastIndex(req.ID, "/")
+	if separator <= 0 || separator == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Invalid import id",
+			fmt.Sprintf("Expected an import id like team_name/user_email, got: %s", req.ID),
+		)
+		return
+	}
+	teamName, userEmail := req.ID[:separator], req.ID[separator+1:]
+
+	team, err := r.client.TeamsClient.GetTeamByName(ctx, teamName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to import team membership, got error: %s", err))
+		return
+	}
+
+	user, err := r.client.UsersClient.GetUserByEmail(ctx, userEmail)
+	if err != nil {
+		resp.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to import team membership, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), team.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), int64(user.UserId))...)
+}
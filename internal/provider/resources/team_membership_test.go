@@ -51,6 +51,14 @@ func TestAccResourceTeamMembershipBasic(t *testing.T) {
 					resource.TestCheckResourceAttrPtr("dagster_team_membership.this", "user_id", &userId),
 				),
 			},
+			// Import by team_name/user_email
+			{
+				ResourceName:                         "dagster_team_membership.this",
+				ImportState:                          true,
+				ImportStateId:                        teamName + "/test-user@dataroots.io",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+			},
 		},
 	})
 }
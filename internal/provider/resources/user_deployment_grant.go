@@ -0,0 +1,305 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &UserDeploymentGrantResource{}
+
+func NewUserDeploymentGrantResource() resource.Resource {
+	return &UserDeploymentGrantResource{}
+}
+
+type UserDeploymentGrantResource struct {
+	client client.DagsterClient
+}
+
+type UserDeploymentGrantResourceModel struct {
+	DeploymentId       types.Int64  `tfsdk:"deployment_id"`
+	UserEmail          types.String `tfsdk:"user_email"`
+	Grant              types.String `tfsdk:"grant"`
+	CodeLocationGrants types.Set    `tfsdk:"code_location_grants"`
+	Id                 types.Int64  `tfsdk:"id"`
+}
+
+func (r *UserDeploymentGrantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_deployment_grant"
+}
+
+func (r *UserDeploymentGrantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a user deployment grant, which grants a user permissions on a deployment and its code locations directly instead of through a team.",
+
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.Int64Attribute{
+				MarkdownDescription: "Id of the deployment the user is granted permissions on",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grant": schema.StringAttribute{
+				MarkdownDescription: "Grant of the user on the deployment",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(clientTypes.DeploymentGrantEnumValues()...),
+				},
+			},
+			"code_location_grants": schema.SetNestedAttribute{
+				MarkdownDescription: "Grants of the user on code locations of the deployment, these can't be less permissive than `grant`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Code location Name",
+							Required:            true,
+						},
+						"grant": schema.StringAttribute{
+							MarkdownDescription: "Code location Grant",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(clientTypes.LocationGrantEnumValues()...),
+							},
+						},
+					},
+				},
+				Optional: true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "User Deployment Grant Id, changes on every update",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *UserDeploymentGrantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserDeploymentGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserDeploymentGrantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userDeploymentGrant, err := r.createOrUpdateUserDeploymentGrant(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user deployment grant, got error: %s", err))
+		return
+	}
+
+	data.Id = types.Int64Value(int64(userDeploymentGrant.Id))
+
+	tflog.Trace(ctx, "created user deployment grant resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserDeploymentGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserDeploymentGrantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userDeploymentGrant, err := r.client.UsersClient.GetUserDeploymentGrantByEmailAndDeploymentId(
+		ctx,
+		data.UserEmail.ValueString(),
+		int(data.DeploymentId.ValueInt64()),
+	)
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "User Deployment Grant not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user deployment grant, got error: %s", err))
+		}
+		return
+	}
+
+	data.Id = types.Int64Value(int64(userDeploymentGrant.Id))
+	data.Grant = types.StringValue(string(userDeploymentGrant.Grant))
+
+	// Keep a null set when no code location grants are configured nor granted
+	if !data.CodeLocationGrants.IsNull() || len(userDeploymentGrant.LocationGrants) > 0 {
+		codeLocationGrants, diags := codeLocationGrantsToSet(userDeploymentGrant.LocationGrants)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.CodeLocationGrants = codeLocationGrants
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserDeploymentGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserDeploymentGrantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userDeploymentGrant, err := r.createOrUpdateUserDeploymentGrant(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user deployment grant, got error: %s", err))
+		return
+	}
+
+	data.Id = types.Int64Value(int64(userDeploymentGrant.Id))
+
+	tflog.Trace(ctx, "updated user deployment grant resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserDeploymentGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserDeploymentGrantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UsersClient.RemoveUserPermission(
+		ctx,
+		data.UserEmail.ValueString(),
+		int(data.DeploymentId.ValueInt64()),
+		clientSchema.PermissionDeploymentScopeDeployment,
+	)
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "User not found, probably already deleted manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user deployment grant, got error: %s", err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted user deployment grant resource with UserEmail=%s DeploymentId=%d", data.UserEmail.ValueString(), data.DeploymentId.ValueInt64()))
+}
+
+func (r *UserDeploymentGrantResource) createOrUpdateUserDeploymentGrant(ctx context.Context, data UserDeploymentGrantResourceModel) (clientSchema.ScopedPermissionGrant, error) {
+	grantEnum, err := clientTypes.ConvertToGrantEnum(data.Grant.ValueString())
+	if err != nil {
+		return clientSchema.ScopedPermissionGrant{}, err
+	}
+
+	codeLocationGrants, err := codeLocationGrantsFromSet(data.CodeLocationGrants)
+	if err != nil {
+		return clientSchema.ScopedPermissionGrant{}, err
+	}
+
+	return r.client.UsersClient.CreateOrUpdateUserDeploymentGrant(
+		ctx,
+		data.UserEmail.ValueString(),
+		int(data.DeploymentId.ValueInt64()),
+		grantEnum,
+		codeLocationGrants,
+	)
+}
+
+// codeLocationGrantsFromSet converts the `code_location_grants` attribute to location grants
+func codeLocationGrantsFromSet(set types.Set) ([]clientSchema.LocationScopedGrant, error) {
+	codeLocationGrants := make([]clientSchema.LocationScopedGrant, 0, len(set.Elements()))
+
+	for _, codeLocationGrant := range set.Elements() {
+		attributes := codeLocationGrant.(types.Object).Attributes()
+
+		grant, err := clientTypes.ConvertToGrantEnum(attributes["grant"].(types.String).ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		codeLocationGrants = append(
+			codeLocationGrants,
+			clientSchema.LocationScopedGrant{
+				LocationName: attributes["name"].(types.String).ValueString(),
+				Grant:        grant,
+			},
+		)
+	}
+
+	return codeLocationGrants, nil
+}
+
+var codeLocationGrantAttributeTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"grant": types.StringType,
+}
+
+// codeLocationGrantsToSet converts the location grants of a scoped permission grant to the `code_location_grants` attribute
+func codeLocationGrantsToSet(locationGrants []clientSchema.ScopedPermissionGrantLocationGrantsLocationScopedGrant) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elementType := types.ObjectType{AttrTypes: codeLocationGrantAttributeTypes}
+	elements := make([]attr.Value, 0, len(locationGrants))
+
+	for _, locationGrant := range locationGrants {
+		element, objectDiags := types.ObjectValue(codeLocationGrantAttributeTypes, map[string]attr.Value{
+			"name":  types.StringValue(locationGrant.LocationName),
+			"grant": types.StringValue(string(locationGrant.Grant)),
+		})
+		diags.Append(objectDiags...)
+		elements = append(elements, element)
+	}
+
+	if diags.HasError() {
+		return types.SetNull(elementType), diags
+	}
+
+	set, setDiags := types.SetValue(elementType, elements)
+	diags.Append(setDiags...)
+
+	return set, diags
+}
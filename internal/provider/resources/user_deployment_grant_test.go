@@ -0,0 +1,74 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccResourceUserDeploymentGrantConfig(email string, clName string, deploymentGrant string, clGrant string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+data "dagster_current_deployment" "current" {}
+
+resource "dagster_user" "test" {
+  email                      = "%s"
+  remove_default_permissions = true
+}
+
+resource "dagster_code_location" "test" {
+  name        = "%s"
+  image       = "python:3.13"
+  code_source = {
+    python_file = "test.py"
+  }
+}
+
+resource "dagster_user_deployment_grant" "test" {
+  deployment_id = data.dagster_current_deployment.current.id
+  user_email    = dagster_user.test.email
+
+  grant = "%s"
+
+  code_location_grants = [
+    {
+      name  = dagster_code_location.test.name
+      grant = "%s"
+    },
+  ]
+}
+`, email, clName, deploymentGrant, clGrant)
+}
+
+func TestAccResourceBasicUserDeploymentGrant(t *testing.T) {
+	email := "acc-test-user-grant-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum) + "@dataroots.io"
+	clName := "code-location-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserDeploymentGrantConfig(email, clName, "VIEWER", "LAUNCHER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_user_deployment_grant.test", "user_email", email),
+					resource.TestCheckResourceAttr("dagster_user_deployment_grant.test", "grant", "VIEWER"),
+					resource.TestCheckResourceAttr("dagster_user_deployment_grant.test", "code_location_grants.#", "1"),
+					resource.TestCheckResourceAttrSet("dagster_user_deployment_grant.test", "id"),
+				),
+			},
+			{
+				Config: testAccResourceUserDeploymentGrantConfig(email, clName, "LAUNCHER", "EDITOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_user_deployment_grant.test", "grant", "LAUNCHER"),
+					resource.TestCheckTypeSetElemNestedAttrs("dagster_user_deployment_grant.test", "code_location_grants.*", map[string]string{
+						"name":  clName,
+						"grant": "EDITOR",
+					}),
+				),
+			},
+		},
+	})
+}
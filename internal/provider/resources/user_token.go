@@ -0,0 +1,241 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/client"
+	clientSchema "github.com/datarootsio/terraform-provider-dagster/internal/client/schema"
+	clientTypes "github.com/datarootsio/terraform-provider-dagster/internal/client/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &UserTokenResource{}
+	_ resource.ResourceWithImportState = &UserTokenResource{}
+)
+
+func NewUserTokenResource() resource.Resource {
+	return &UserTokenResource{}
+}
+
+type UserTokenResource struct {
+	client client.DagsterClient
+}
+
+type UserTokenResourceModel struct {
+	Id              types.Int64   `tfsdk:"id"`
+	UserId          types.Int64   `tfsdk:"user_id"`
+	Description     types.String  `tfsdk:"description"`
+	Token           types.String  `tfsdk:"token"`
+	CreateTimestamp types.Float64 `tfsdk:"create_timestamp"`
+}
+
+func (r *UserTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_token"
+}
+
+func (r *UserTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a user token, an API token that acts on behalf of a user.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "User token id",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the user the token belongs to, for example `dagster_user.id`",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "User token description. DEFAULT `\"\"`",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "User token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_timestamp": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the creation of the token",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.DagsterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.DagsterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validation
+	_, err := r.client.UsersClient.GetUserById(ctx, data.UserId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user token, got error: %s", err))
+		return
+	}
+
+	token, err := r.client.TokensClient.CreateUserToken(ctx, int(data.UserId.ValueInt64()), data.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user token, got error: %s", err))
+		return
+	}
+
+	setUserTokenResourceModel(&data, token)
+
+	tflog.Trace(ctx, fmt.Sprintf("created user token with id: %d", token.Id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.TokensClient.GetUserTokenById(ctx, int(data.UserId.ValueInt64()), int(data.Id.ValueInt64()))
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "User token not found, probably already revoked manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user token, got error: %s", err))
+		}
+		return
+	}
+
+	setUserTokenResourceModel(&data, token)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.TokensClient.EditUserTokenDescription(
+		ctx,
+		int(data.UserId.ValueInt64()),
+		int(data.Id.ValueInt64()),
+		data.Description.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user token, got error: %s", err))
+		return
+	}
+
+	setUserTokenResourceModel(&data, token)
+
+	tflog.Trace(ctx, "updated user token resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.TokensClient.RevokeUserToken(ctx, int(data.UserId.ValueInt64()), int(data.Id.ValueInt64()))
+	if err != nil {
+		var errComp *clientTypes.ErrNotFound
+		if errors.As(err, &errComp) {
+			tflog.Trace(ctx, "User token not found, probably already revoked manually, removing from state")
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke user token, got error: %s", err))
+		}
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("revoked user token with id: %d", data.Id.ValueInt64()))
+}
+
+// ImportState imports a user token by `user_id/token_id`
+func (r *UserTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userIdString, tokenIdString, ok := strings.Cut(req.ID, "/")
+
+	userId, errUserId := strconv.ParseInt(userIdString, 10, 64)
+	tokenId, errTokenId := strconv.ParseInt(tokenIdString, 10, 64)
+	if !ok || errUserId != nil || errTokenId != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import id",
+			fmt.Sprintf("Expected an import id like user_id/token_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), tokenId)...)
+}
+
+func setUserTokenResourceModel(data *UserTokenResourceModel, token clientSchema.UserToken) {
+	data.Id = types.Int64Value(int64(token.Id))
+	data.Description = types.StringValue(token.Description)
+	data.Token = types.StringValue(token.Token)
+	data.CreateTimestamp = types.Float64Value(token.CreateTimestamp)
+}
@@ -0,0 +1,59 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarootsio/terraform-provider-dagster/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccResourceUserTokenConfig(description string) string {
+	return fmt.Sprintf(testutils.ProviderConfig+`
+resource "dagster_user" "test" {
+  email                      = "acc-test-user-token@dataroots.io"
+  remove_default_permissions = true
+}
+
+resource "dagster_user_token" "test" {
+  user_id     = dagster_user.test.id
+  description = "%s"
+}
+`, description)
+}
+
+func TestAccResource_userToken_basic(t *testing.T) {
+	var userId, tokenId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserTokenConfig("tf-acc-user-token"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_user_token.test", "description", "tf-acc-user-token"),
+					resource.TestCheckResourceAttrSet("dagster_user_token.test", "token"),
+					resource.TestCheckResourceAttrPair("dagster_user_token.test", "user_id", "dagster_user.test", "id"),
+					testutils.FetchValueFromState("dagster_user.test", "id", &userId),
+					testutils.FetchValueFromState("dagster_user_token.test", "id", &tokenId),
+				),
+			},
+			// Changing the description keeps the token
+			{
+				Config: testAccResourceUserTokenConfig("tf-acc-user-token-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dagster_user_token.test", "description", "tf-acc-user-token-updated"),
+					resource.TestCheckResourceAttrPtr("dagster_user_token.test", "id", &tokenId),
+				),
+			},
+			{
+				ResourceName:      "dagster_user_token.test",
+				ImportState:       true,
+				ImportStateIdFunc: func(_ *terraform.State) (string, error) { return userId + "/" + tokenId, nil },
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
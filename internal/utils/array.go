@@ -8,3 +8,24 @@ func IndexOf[T comparable](arr []T, val T) int {
 	}
 	return -1
 }
+
+// HasSameElements checks whether two slices contain the same elements, regardless of their order
+func HasSameElements[T comparable](a []T, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[T]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+
+	return true
+}
@@ -19,3 +19,42 @@ func MakeJSONStringUniform(input json.RawMessage) (string, error) {
 
 	return string(output), nil
 }
+
+// FilterJSONKeys returns the actual JSON document as a uniform JSON string, limited to the keys present in the declared JSON document.
+// Nested objects are filtered recursively, keys that are declared but missing in the actual document are left out.
+func FilterJSONKeys(declared json.RawMessage, actual json.RawMessage) (string, error) {
+	var declaredJSON, actualJSON map[string]interface{}
+	if err := json.Unmarshal(declared, &declaredJSON); err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(actual, &actualJSON); err != nil {
+		return "", err
+	}
+
+	output, err := json.Marshal(filterKeys(declaredJSON, actualJSON))
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+func filterKeys(declared map[string]interface{}, actual map[string]interface{}) map[string]interface{} {
+	filtered := make(map[string]interface{}, len(declared))
+	for key, declaredValue := range declared {
+		actualValue, ok := actual[key]
+		if !ok {
+			continue
+		}
+
+		declaredMap, declaredIsMap := declaredValue.(map[string]interface{})
+		actualMap, actualIsMap := actualValue.(map[string]interface{})
+		if declaredIsMap && actualIsMap {
+			filtered[key] = filterKeys(declaredMap, actualMap)
+		} else {
+			filtered[key] = actualValue
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashStringWithSalt returns the hex encoded HMAC-SHA-256 of a string keyed by a salt, so short or guessable
+// values can't be recovered from the hash without the salt
+func HashStringWithSalt(salt string, input string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(input))
+	return hex.EncodeToString(mac.Sum(nil))
+}